@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect or clear the on-disk response cache",
+	Long:  `Golazo keeps FotMob league and match responses on disk so restarts don't refetch everything. Finished matches are kept until the size cap is reached; everything else is revalidated with FotMob before use.`,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show cache location, size and entry counts",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cache := openDiskCache()
+		stats := cache.Stats()
+
+		fmt.Printf("Location:        %s\n", stats.Dir)
+		fmt.Printf("Entries:         %d (%d finished matches)\n", stats.Entries, stats.Final)
+		fmt.Printf("Size:            %s of %s\n", formatBytes(stats.Bytes), formatBytes(stats.MaxBytes))
+		if !stats.Oldest.IsZero() {
+			fmt.Printf("Oldest entry:    %s\n", stats.Oldest.Format(time.RFC1123))
+		}
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove every cached response",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cache := openDiskCache()
+		stats := cache.Stats()
+		if err := cache.Clear(); err != nil {
+			fmt.Fprintf(os.Stderr, "Clearing cache failed: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Removed %d entries (%s)\n", stats.Entries, formatBytes(stats.Bytes))
+	},
+}
+
+// openDiskCache opens the response cache or exits with an error.
+func openDiskCache() *fotmob.DiskCache {
+	cache, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Opening cache failed: %v\n", err)
+		os.Exit(1)
+	}
+	return cache
+}
+
+// formatBytes formats a byte count as "12.3 MB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/daemon"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/0xjuanma/golazo/internal/server"
+	"github.com/0xjuanma/golazo/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Poll live matches and send notifications in the background",
+	Long: `Runs the live match polling, caching and notifications without the TUI, so notifications keep coming with no golazo window open.
+
+golazo attaches to a running daemon: it shows the daemon's data instead of fetching its own, and leaves the notifications to the daemon. Every terminal then shares one poller (start golazo with --no-daemon to run on its own).
+
+The daemon listens on a Unix socket in the runtime directory ($XDG_RUNTIME_DIR/golazo, or golazo-<uid> in the temp directory), serving the same API as golazo serve. Notification settings are read when it starts.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, settings, err := newProvider()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		data.ApplyTimeSettings(settings)
+
+		// Unlike the TUI, the daemon doesn't fall back to desktop
+		// notifications: nobody would see why the backends were ignored
+		dispatcher, err := notify.FromSettings(settings.Notifications, nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v (see golazo notify test)\n", err)
+			os.Exit(exitError)
+		}
+		defer dispatcher.Close()
+		notified, _ := data.LoadNotifiedLog()
+		notifier := notify.NewThrottle(dispatcher, settings.Notifications, notified)
+		defer notifier.Flush()
+		rules := settings.NotificationRules()
+
+		path, err := daemon.SocketPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		listener, err := daemon.Listen(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		srv := server.New(client, server.Options{
+			PollInterval: pollInterval(client),
+			OnPoll: func(update watch.Update) {
+				for _, match := range update.Matches {
+					for _, n := range notify.Notifications(rules, match.Details, match.Changes) {
+						if err := notifier.Notify(n); err != nil {
+							fmt.Fprintf(os.Stderr, "Notification failed: %v\n", err)
+						}
+					}
+				}
+			},
+		})
+		httpServer := &http.Server{Handler: srv.Handler(), ReadHeaderTimeout: 10 * time.Second}
+		go srv.Run(ctx)
+		go func() {
+			<-ctx.Done()
+			shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = httpServer.Shutdown(shutdown)
+		}()
+
+		fmt.Fprintf(os.Stderr, "Listening on %s\n", path)
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+	},
+}
+
+func init() {
+	addProviderFlags(daemonCmd)
+	rootCmd.AddCommand(daemonCmd)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var matchCmd = &cobra.Command{
+	Use:   "match ID",
+	Short: "Show a match's score and events",
+	Long:  `Shows a match (by FotMob match ID, as listed by live, results and fixtures) with its events. JSON and YAML output include the statistics and line-ups too.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query{
+			none: "Match not found",
+			fetch: func(ctx context.Context, client api.Provider) (output.Table, error) {
+				id, err := strconv.Atoi(args[0])
+				if err != nil || id <= 0 {
+					return output.Table{}, fmt.Errorf("invalid match ID %q", args[0])
+				}
+				details, err := client.MatchDetails(ctx, id)
+				if err != nil {
+					return output.Table{}, fmt.Errorf("match %d: %w", id, err)
+				}
+				if details == nil {
+					return output.Table{}, nil // Unknown ID: exits with exitNoData
+				}
+				return matchDetailsTable(details), nil
+			},
+		}.run()
+	},
+}
+
+// matchDetailsTable summarises a match above its events, one per row.
+func matchDetailsTable(details *api.MatchDetails) output.Table {
+	match := details.Match
+	table := output.Table{
+		Summary: []string{
+			fmt.Sprintf("%s %s %s  (%s)", match.HomeTeam.Name, score(match.HomeScore, match.AwayScore), match.AwayTeam.Name, matchStatus(match)),
+		},
+		Headers: []string{"minute", "team", "type", "player", "detail"},
+		Value:   details,
+	}
+
+	competition := match.League.Name
+	if match.Round != "" {
+		competition += " · " + match.Round
+	}
+	table.Summary = append(table.Summary, competition)
+	if match.MatchTime != nil {
+		table.Summary = append(table.Summary, "Kickoff: "+data.FormatDate(*match.MatchTime, "Mon 2 Jan 2006")+" "+data.FormatClock(*match.MatchTime))
+	}
+	if details.Venue != "" {
+		table.Summary = append(table.Summary, "Venue: "+details.Venue)
+	}
+	if details.Referee != "" {
+		table.Summary = append(table.Summary, "Referee: "+details.Referee)
+	}
+
+	for _, event := range details.Events {
+		var detail []string
+		if event.EventType != nil && *event.EventType != "" {
+			detail = append(detail, *event.EventType)
+		}
+		if event.Assist != nil && *event.Assist != "" {
+			detail = append(detail, "assist: "+*event.Assist)
+		}
+		table.Rows = append(table.Rows, []string{
+			fmt.Sprintf("%d'", event.Minute),
+			event.Team.Name,
+			event.Type,
+			stringValue(event.Player),
+			strings.Join(detail, ", "),
+		})
+	}
+	return table
+}
+
+// stringValue returns *s, "" for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func init() {
+	addOutputFlag(matchCmd)
+	addProviderFlags(matchCmd)
+	rootCmd.AddCommand(matchCmd)
+}
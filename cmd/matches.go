@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// --date and --days of the results and fixtures commands
+var matchesDate string
+var matchesDays int
+
+var liveCmd = &cobra.Command{
+	Use:   "live",
+	Short: "List the matches in progress in your leagues",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		query{
+			none: "No live matches",
+			fetch: func(ctx context.Context, client api.Provider) (output.Table, error) {
+				matches, err := client.LiveMatches(ctx)
+				return matchTable(withStatus(matches, api.MatchStatusLive)), err
+			},
+		}.run()
+	},
+}
+
+var resultsCmd = &cobra.Command{
+	Use:   "results",
+	Short: "List finished matches in your leagues",
+	Long:  `Lists the finished matches of a day (today unless --date is set) in the leagues selected in settings, or of several days back from it with --days.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		query{
+			none: "No finished matches",
+			fetch: func(ctx context.Context, client api.Provider) (output.Table, error) {
+				matches, err := matchesOnDays(ctx, -1, client.ResultsByDate)
+				return matchTable(withStatus(matches, api.MatchStatusFinished)), err
+			},
+		}.run()
+	},
+}
+
+var fixturesCmd = &cobra.Command{
+	Use:   "fixtures",
+	Short: "List upcoming matches in your leagues",
+	Long:  `Lists the matches still to be played on a day (today unless --date is set) in the leagues selected in settings, or on several days from it with --days.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		query{
+			none: "No upcoming matches",
+			fetch: func(ctx context.Context, client api.Provider) (output.Table, error) {
+				matches, err := matchesOnDays(ctx, 1, client.MatchesByDate)
+				return matchTable(withStatus(matches, api.MatchStatusNotStarted)), err
+			},
+		}.run()
+	},
+}
+
+// matchesOnDays fetches the matches of --days days from --date, going back
+// (step -1) or forward (step 1), in kickoff order. Days that fail don't stop
+// the others; the result is then marked partial.
+func matchesOnDays(ctx context.Context, step int, fetch func(context.Context, time.Time) ([]api.Match, error)) ([]api.Match, error) {
+	start, err := data.ParseDate(matchesDate)
+	if err != nil {
+		return nil, err
+	}
+	if matchesDays < 1 {
+		return nil, fmt.Errorf("--days must be at least 1")
+	}
+
+	var all []api.Match
+	var errs []error
+	loaded := false
+	for i := 0; i < matchesDays; i++ {
+		date := start.AddDate(0, 0, i*step)
+		matches, err := fetch(ctx, date)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", data.DateKey(date), err))
+		}
+		if err == nil || isPartial(err) {
+			loaded = true
+		}
+		all = append(all, matches...)
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return kickoff(all[i]).Before(kickoff(all[j]))
+	})
+	err = errors.Join(errs...)
+	if err != nil && loaded {
+		err = &partialError{err: err}
+	}
+	return all, err
+}
+
+// withStatus keeps the matches with status.
+func withStatus(matches []api.Match, status api.MatchStatus) []api.Match {
+	kept := make([]api.Match, 0, len(matches))
+	for _, match := range matches {
+		if match.Status == status {
+			kept = append(kept, match)
+		}
+	}
+	return kept
+}
+
+// kickoff returns a match's kickoff time, zero when unknown.
+func kickoff(match api.Match) time.Time {
+	if match.MatchTime == nil {
+		return time.Time{}
+	}
+	return *match.MatchTime
+}
+
+// matchTable lists matches one per row, with the full matches for JSON/YAML.
+func matchTable(matches []api.Match) output.Table {
+	if matches == nil {
+		matches = []api.Match{} // [] rather than null in JSON
+	}
+	table := output.Table{
+		Headers: []string{"id", "date", "time", "league", "home", "score", "away", "status"},
+		Value:   matches,
+	}
+	for _, match := range matches {
+		date, clock := "", ""
+		if match.MatchTime != nil {
+			date = data.FormatDate(*match.MatchTime, "2006-01-02")
+			clock = data.FormatClock(*match.MatchTime)
+		}
+		table.Rows = append(table.Rows, []string{
+			strconv.Itoa(match.ID),
+			date,
+			clock,
+			match.League.Name,
+			match.HomeTeam.Name,
+			score(match.HomeScore, match.AwayScore),
+			match.AwayTeam.Name,
+			matchStatus(match),
+		})
+	}
+	return table
+}
+
+// score formats a score as "2-1", "-" before kickoff.
+func score(home, away *int) string {
+	if home == nil || away == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d-%d", *home, *away)
+}
+
+// matchStatus describes a match's state: the clock of a live match, "FT",
+// or the status.
+func matchStatus(match api.Match) string {
+	switch match.Status {
+	case api.MatchStatusLive:
+		if match.LiveTime != nil && *match.LiveTime != "" {
+			return *match.LiveTime
+		}
+		return "live"
+	case api.MatchStatusFinished:
+		return "FT"
+	case api.MatchStatusNotStarted:
+		return "-"
+	}
+	return string(match.Status)
+}
+
+func init() {
+	resultsCmd.Flags().StringVar(&matchesDate, "date", "", "Day to list, as `YYYY-MM-DD` (default today)")
+	resultsCmd.Flags().IntVar(&matchesDays, "days", 1, "Number of days to list, going back from --date")
+	fixturesCmd.Flags().StringVar(&matchesDate, "date", "", "Day to list, as `YYYY-MM-DD` (default today)")
+	fixturesCmd.Flags().IntVar(&matchesDays, "days", 1, "Number of days to list, going forward from --date")
+
+	for _, cmd := range []*cobra.Command{liveCmd, resultsCmd, fixturesCmd} {
+		addOutputFlag(cmd)
+		addProviderFlags(cmd)
+		rootCmd.AddCommand(cmd)
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Check the notification backends",
+	Long:  `Notifications are sent by the backends under notifications.backends in settings.yaml (desktop notifications when none are set): webhooks, ntfy or gotify pushes and commands, all alongside each other.`,
+}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test [NAME...]",
+	Short: "Send a test notification through each backend (or the named ones)",
+	Run: func(cmd *cobra.Command, args []string) {
+		settings, err := data.LoadSettings()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Loading settings failed: %v\n", err)
+			os.Exit(1)
+		}
+		if err := settings.Notifications.QuietHours.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v (quiet hours are off)\n", err)
+		}
+		backends, err := notify.Backends(settings.Notifications, nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		sample := notify.Sample()
+		sent, failed := 0, 0
+		for _, backend := range backends {
+			if !selected(args, backend.Name) {
+				continue
+			}
+			if err := backend.Send(context.Background(), sample); err != nil {
+				fmt.Printf("✗ %s (%s): %v\n", backend.Name, backend.Type, err)
+				failed++
+				continue
+			}
+			fmt.Printf("✓ %s (%s)\n", backend.Name, backend.Type)
+			sent++
+		}
+
+		if sent+failed == 0 {
+			fmt.Fprintf(os.Stderr, "No backend named %v\n", args)
+			os.Exit(1)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// selected reports whether name is in names, or names is empty.
+func selected(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return len(names) == 0
+}
+
+func init() {
+	notifyCmd.AddCommand(notifyTestCmd)
+	rootCmd.AddCommand(notifyCmd)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the query commands (live, results, fixtures, table, match),
+// so scripts can tell an empty result from a failure.
+const (
+	exitError   = 1 // Request failed or invalid arguments
+	exitNoData  = 2 // Nothing to show (e.g., no live matches)
+	exitPartial = 3 // Printed, but some leagues or days failed to load
+)
+
+// queryTimeout bounds all the requests of a query command.
+const queryTimeout = 30 * time.Second
+
+// outputFormat is the --output flag of the query commands.
+var outputFormat string
+
+// query fetches a command's result. none is printed to stderr (in text
+// output) when the result is empty.
+type query struct {
+	none  string
+	fetch func(ctx context.Context, client api.Provider) (output.Table, error)
+}
+
+// partialError marks a result that is missing some of its data, for failures
+// that aren't an *api.PartialResultError (a day of a date range failing).
+type partialError struct {
+	err error
+}
+
+func (e *partialError) Error() string { return e.err.Error() }
+func (e *partialError) Unwrap() error { return e.err }
+
+// isPartial reports whether err left the result incomplete rather than empty.
+func isPartial(err error) bool {
+	var marked *partialError
+	if errors.As(err, &marked) {
+		return true
+	}
+	partial, ok := api.AsPartialResult(err)
+	return ok && !partial.AllFailed()
+}
+
+// run prints a query's result in --output format and exits with exitNoData
+// or exitPartial when it is empty or incomplete.
+func (q query) run() {
+	if err := output.Validate(outputFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	client, settings, err := newProvider()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	data.ApplyTimeSettings(settings)
+
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	table, err := q.fetch(ctx, client)
+	cancel()
+	if err != nil && !isPartial(err) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if err := output.Write(os.Stdout, outputFormat, table); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	empty := len(table.Rows) == 0 && len(table.Summary) == 0
+
+	switch {
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "Warning: incomplete results: %v\n", err)
+		os.Exit(exitPartial)
+	case empty:
+		if outputFormat == output.Text {
+			fmt.Fprintln(os.Stderr, q.none)
+		}
+		os.Exit(exitNoData)
+	}
+}
+
+// addOutputFlag adds --output to a query command.
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", output.Text, "Output `FORMAT`: text, json, csv or yaml")
+}
@@ -2,12 +2,18 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"runtime"
 
+	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/app"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/daemon"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httprec"
+	"github.com/0xjuanma/golazo/internal/provider"
 	"github.com/0xjuanma/golazo/internal/ui"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -21,6 +27,11 @@ var Version = "dev"
 var mockFlag bool
 var updateFlag bool
 var versionFlag bool
+var recordDir string
+var replayDir string
+var scenarioPaths []string
+var mockSpeed float64
+var noDaemonFlag bool
 
 var rootCmd = &cobra.Command{
 	Use:   "golazo",
@@ -37,7 +48,13 @@ var rootCmd = &cobra.Command{
 			return
 		}
 
-		p := tea.NewProgram(app.New(mockFlag), tea.WithAltScreen())
+		client, err := tuiProvider(cmd)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		p := tea.NewProgram(app.New(client), tea.WithAltScreen())
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
@@ -45,6 +62,68 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// newProvider creates the data provider selected in settings, or the one
+// picked by --mock, --scenario, --record and --replay, along with the settings.
+func newProvider() (api.Provider, *data.Settings, error) {
+	transport, err := sessionTransport()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, _ := data.LoadSettings()
+	if mockFlag || len(scenarioPaths) > 0 {
+		settings.Provider = provider.Mock
+	}
+	if len(scenarioPaths) > 0 {
+		settings.Mock.Scenarios = scenarioPaths
+	}
+	if mockSpeed > 0 {
+		settings.Mock.Speed = mockSpeed
+	}
+	client, err := provider.New(settings, transport)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, settings, nil
+}
+
+// tuiProvider returns the provider for the TUI: the running daemon's, if
+// any, unless --no-daemon or a provider flag asks for a session of its own.
+func tuiProvider(cmd *cobra.Command) (api.Provider, error) {
+	own := noDaemonFlag
+	for _, name := range []string{"mock", "record", "replay", "scenario", "mock-speed"} {
+		own = own || cmd.Flags().Changed(name)
+	}
+	if !own {
+		if client, err := daemon.Attach(); err == nil {
+			return client, nil
+		}
+	}
+
+	client, _, err := newProvider()
+	return client, err
+}
+
+// sessionTransport returns the transport for --record or --replay, or nil.
+// Replaying also moves the app's clock to the time the session was recorded,
+// so "today" and the live views match what was seen then.
+func sessionTransport() (http.RoundTripper, error) {
+	switch {
+	case recordDir != "" && replayDir != "":
+		return nil, fmt.Errorf("--record and --replay can't be used together")
+	case recordDir != "":
+		return httprec.NewRecorder(recordDir, nil)
+	case replayDir != "":
+		replayer, err := httprec.NewReplayer(replayDir)
+		if err != nil {
+			return nil, err
+		}
+		data.SetClockOffset(replayer.Offset())
+		return replayer, nil
+	}
+	return nil, nil
+}
+
 // printVersion displays the ASCII logo with gradient and version.
 func printVersion() {
 	// Render ASCII title with gradient (same as main view)
@@ -89,7 +168,18 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.Flags().BoolVar(&mockFlag, "mock", false, "Use mock data for all views instead of real API data")
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
+	rootCmd.Flags().BoolVar(&noDaemonFlag, "no-daemon", false, "Fetch and notify on its own even if golazo daemon is running")
+	addProviderFlags(rootCmd)
+}
+
+// addProviderFlags adds the flags choosing the data provider to a command
+// that uses newProvider.
+func addProviderFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&mockFlag, "mock", false, "Use mock data for all views instead of real API data")
+	cmd.Flags().StringVar(&recordDir, "record", "", "Record every API request and response to `DIR`")
+	cmd.Flags().StringVar(&replayDir, "replay", "", "Replay a session recorded with --record from `DIR` (offline)")
+	cmd.Flags().StringArrayVar(&scenarioPaths, "scenario", nil, "Simulate the match in a YAML/JSON scenario `FILE` or directory (implies --mock, repeatable)")
+	cmd.Flags().Float64Var(&mockSpeed, "mock-speed", 0, "Simulated match minutes per real minute with --mock (default 15)")
 }
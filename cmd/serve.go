@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/server"
+	"github.com/spf13/cobra"
+)
+
+// Flags of the serve command
+var serveAddr string
+var serveCORSOrigins []string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve matches, tables and live events over HTTP",
+	Long: `Runs a local HTTP server sharing one provider (and its cache and rate limiter) between every client:
+
+  GET /matches?date=YYYY-MM-DD   matches of a day in your leagues (default today)
+  GET /results?date=YYYY-MM-DD   matches of a day that can have finished
+  GET /live                      live matches in your leagues
+  GET /matches/{id}              match details
+  GET /leagues                   available leagues
+  GET /leagues/{id}/matches      fixtures and results of a league's current season
+  GET /leagues/{id}/live         live matches in a league
+  GET /leagues/{id}/season       league season (?season=NAME, default current)
+  GET /leagues/{id}/table        league table
+  GET /teams?q=NAME              teams matching a name
+  GET /events                    Server-Sent Events stream of live match changes (?match=ID, ?league=ID),
+                                 resumed from Last-Event-ID on reconnect
+  GET /healthz                   server and live polling status
+
+Responses are JSON. When some leagues fail to load, lists name them in the X-Failed-Leagues header; provider failures answer 502.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, settings, err := newProvider()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		data.ApplyTimeSettings(settings)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		srv := server.New(client, server.Options{CORSOrigins: serveCORSOrigins, PollInterval: pollInterval(client)})
+		httpServer := &http.Server{Addr: serveAddr, Handler: srv.Handler(), ReadHeaderTimeout: 10 * time.Second}
+		go srv.Run(ctx)
+		go func() {
+			<-ctx.Done()
+			shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = httpServer.Shutdown(shutdown)
+		}()
+
+		fmt.Fprintf(os.Stderr, "Serving on http://%s\n", serveAddr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "localhost:8080", "Address to listen on (`HOST:PORT`)")
+	serveCmd.Flags().StringArrayVar(&serveCORSOrigins, "cors-origin", nil, "Allow browser requests from `ORIGIN` (e.g., http://localhost:3000, or * for any; repeatable)")
+	addProviderFlags(serveCmd)
+	rootCmd.AddCommand(serveCmd)
+}
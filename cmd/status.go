@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/statusbar"
+	"github.com/spf13/cobra"
+)
+
+// Flags of the status command
+var statusFormat string
+var statusPreset string
+var statusMaxAge time.Duration
+var statusLimit int
+var statusFavourites bool
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print live scores for a status bar (tmux, polybar, waybar...)",
+	Long: `Prints the live scores of your favourite teams and selected leagues on one line, for tmux, i3blocks, polybar or waybar. Favourites come first; --favourites leaves the other matches out and follows your favourite teams in the leagues they play in, selected in settings or not.
+
+Live matches are fetched at most once per --max-age and shared by every invocation through a state file in the cache directory, so a bar refreshing every few seconds doesn't send a request each time.
+
+--format takes a Go template (text/template) instead of a preset, executed with:
+  .Matches, .Shown   live matches (.Shown: the first --limit), each with .ID .League .Home .Away
+                     (short names) .HomeName .AwayName .HomeScore .AwayScore .Score .Clock .Favourite
+  .More              number of matches left out of .Shown
+  .Text, .Tooltip    the plain line and a line per match with league and full names
+  .Class             "favourite", "live" or "none"
+  .Updated, .Stale   when the matches were fetched, and whether the last fetch failed
+  json               function printing a value as JSON, e.g. {{json .Text}}`,
+	Example: `  golazo status --preset tmux
+  golazo status --preset waybar --favourites
+  golazo status --format '{{range .Shown}}{{.Home}} {{.Score}} {{.Away}}  {{end}}'`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		text := statusFormat
+		if text == "" {
+			preset, ok := statusbar.Presets[statusPreset]
+			if !ok {
+				fmt.Fprintf(os.Stderr, "Error: unknown preset %q (available: plain, tmux, waybar)\n", statusPreset)
+				os.Exit(exitError)
+			}
+			text = preset
+		}
+		tmpl, err := statusbar.Template(text)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --format: %v\n", err)
+			os.Exit(exitError)
+		}
+
+		client, settings, err := newProvider()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		data.ApplyTimeSettings(settings)
+
+		opts := statusbar.Options{
+			MaxAge:     statusMaxAge,
+			Limit:      statusLimit,
+			Favourites: statusFavourites,
+			Shared:     recordDir == "" && replayDir == "",
+		}
+		key := statusbar.Key(settings, statusFavourites)
+		state, err := statusbar.Matches(key, opts, func() ([]api.Match, error) {
+			return fetchStatus(client, settings)
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+
+		var out strings.Builder
+		if err := tmpl.Execute(&out, statusbar.NewLine(state, settings, opts)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		fmt.Println(out.String())
+	},
+}
+
+// fetchStatus fetches the live matches, with --favourites those of the
+// favourite teams' leagues, as watch does. Incomplete results aren't failures.
+func fetchStatus(client api.Provider, settings *data.Settings) ([]api.Match, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	var matches []api.Match
+	var err error
+	if statusFavourites {
+		matches, err = favouritesLive(ctx, client, settings, false)
+	} else {
+		matches, err = client.LiveMatches(ctx)
+	}
+	if err != nil && isPartial(err) {
+		err = nil
+	}
+	return matches, err
+}
+
+func init() {
+	statusCmd.Flags().StringVar(&statusFormat, "format", "", "Go `TEMPLATE` for the line (overrides --preset)")
+	statusCmd.Flags().StringVar(&statusPreset, "preset", "plain", "Output preset: plain (i3blocks, polybar), tmux or waybar")
+	statusCmd.Flags().DurationVar(&statusMaxAge, "max-age", time.Minute, "Reuse live matches fetched less than this long ago")
+	statusCmd.Flags().IntVar(&statusLimit, "limit", 3, "Matches shown on the line (0 for all)")
+	statusCmd.Flags().BoolVar(&statusFavourites, "favourites", false, "Show your favourite teams' matches only")
+	addProviderFlags(statusCmd)
+	rootCmd.AddCommand(statusCmd)
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var tableCmd = &cobra.Command{
+	Use:   "table LEAGUE",
+	Short: "Show a league table",
+	Long:  `Shows the standings of a league, given by FotMob ID (e.g., 47) or name (e.g., "premier league"). Names are looked up in the league catalog shown in settings.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query{
+			none: "No table for this league",
+			fetch: func(ctx context.Context, client api.Provider) (output.Table, error) {
+				league, err := findLeague(args[0])
+				if err != nil {
+					return output.Table{}, err
+				}
+				entries, err := client.LeagueTable(ctx, league.ID)
+				if err != nil {
+					return output.Table{}, fmt.Errorf("%s table: %w", league.Name, err)
+				}
+				return standingsTable(entries), nil
+			},
+		}.run()
+	},
+}
+
+// findLeague looks a league up by ID or name: an exact name first, otherwise
+// the only league whose name contains it.
+func findLeague(arg string) (data.LeagueInfo, error) {
+	if id, err := strconv.Atoi(arg); err == nil {
+		if league, ok := data.LeagueInfoByID(id); ok {
+			return league, nil
+		}
+		return data.LeagueInfo{ID: id, Name: arg}, nil // Not in the catalog, but may still exist
+	}
+
+	var found []data.LeagueInfo
+	for _, league := range data.LeagueCatalog() {
+		if strings.EqualFold(league.Name, arg) {
+			return league, nil
+		}
+		if strings.Contains(strings.ToLower(league.Name), strings.ToLower(arg)) {
+			found = append(found, league)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return data.LeagueInfo{}, fmt.Errorf("no league named %q", arg)
+	case 1:
+		return found[0], nil
+	}
+	names := make([]string, len(found))
+	for i, league := range found {
+		names[i] = fmt.Sprintf("%s (%s, %d)", league.Name, league.Country, league.ID)
+	}
+	return data.LeagueInfo{}, fmt.Errorf("%q matches several leagues, use the ID: %s", arg, strings.Join(names, "; "))
+}
+
+// standingsTable lists a league table's entries, with a group column for
+// competitions with several tables.
+func standingsTable(entries []api.LeagueTableEntry) output.Table {
+	if entries == nil {
+		entries = []api.LeagueTableEntry{} // [] rather than null in JSON
+	}
+	grouped := false
+	for _, entry := range entries {
+		grouped = grouped || entry.Group != ""
+	}
+
+	table := output.Table{
+		Headers: []string{"pos", "team", "p", "w", "d", "l", "gf", "ga", "gd", "pts"},
+		Value:   entries,
+	}
+	if grouped {
+		table.Headers = append([]string{"group"}, table.Headers...)
+	}
+	for _, entry := range entries {
+		row := []string{
+			strconv.Itoa(entry.Position),
+			entry.Team.Name,
+			strconv.Itoa(entry.Played),
+			strconv.Itoa(entry.Won),
+			strconv.Itoa(entry.Drawn),
+			strconv.Itoa(entry.Lost),
+			strconv.Itoa(entry.GoalsFor),
+			strconv.Itoa(entry.GoalsAgainst),
+			fmt.Sprintf("%+d", entry.GoalDifference),
+			strconv.Itoa(entry.Points),
+		}
+		if grouped {
+			row = append([]string{entry.Group}, row...)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+func init() {
+	addOutputFlag(tableCmd)
+	addProviderFlags(tableCmd)
+	rootCmd.AddCommand(tableCmd)
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/app"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+// Flags of the watch command
+var watchMatchID int
+var watchLeagueID int
+var watchFavourites bool
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream match events as JSON lines",
+	Long: `Polls live matches like the live view and writes one JSON object per line to stdout for every change: goals, cards, substitutions, penalties, line-ups and status changes (kickoff, half-time, full-time).
+
+Without flags every live match in your leagues is watched. --match follows one match from before kickoff, --league one league (selected in settings or not) and --favourites the matches of your favourite teams.
+
+Exits at full-time: of the match with --match, otherwise once every match seen has finished and none is live. Interrupting it (Ctrl+C) exits cleanly too.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, settings, err := newProvider()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		data.ApplyTimeSettings(settings)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		scoped, err := watchScope(ctx, client, settings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		if scoped == nil {
+			return // The match is over already
+		}
+
+		if err := streamEvents(ctx, scoped, pollInterval(client)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+	},
+}
+
+// pollInterval returns the app's poll interval for a provider (shorter for
+// simulated matches).
+func pollInterval(client api.Provider) time.Duration {
+	if hint, ok := client.(api.PollIntervalHint); ok {
+		return hint.PollInterval()
+	}
+	return app.PollInterval
+}
+
+// scopedProvider narrows down the live matches a watch.Watcher polls.
+type scopedProvider struct {
+	api.Provider
+	live func(ctx context.Context, force bool) ([]api.Match, error)
+}
+
+func (p scopedProvider) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	return p.live(ctx, false)
+}
+
+func (p scopedProvider) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
+	return p.live(ctx, true)
+}
+
+// watchScope returns the provider to watch through for --match, --league or
+// --favourites (the provider itself without them). It returns nil when the
+// --match match has finished already.
+func watchScope(ctx context.Context, client api.Provider, settings *data.Settings) (api.Provider, error) {
+	switch {
+	case watchMatchID != 0:
+		details, err := client.MatchDetails(ctx, watchMatchID)
+		if err != nil {
+			return nil, fmt.Errorf("match %d: %w", watchMatchID, err)
+		}
+		if details == nil {
+			return nil, fmt.Errorf("match %d not found", watchMatchID)
+		}
+		if details.Status != api.MatchStatusNotStarted && details.Status != api.MatchStatusLive {
+			fmt.Fprintf(os.Stderr, "%s vs %s is over (%s)\n", details.HomeTeam.Name, details.AwayTeam.Name, details.Status)
+			return nil, nil
+		}
+		// The watcher only needs the ID: it fetches the details itself,
+		// kicked off or not
+		match := []api.Match{{ID: watchMatchID}}
+		return scopedProvider{Provider: client, live: func(context.Context, bool) ([]api.Match, error) {
+			return match, nil
+		}}, nil
+
+	case watchLeagueID != 0:
+		return scopedProvider{Provider: client, live: func(ctx context.Context, _ bool) ([]api.Match, error) {
+			return client.LiveMatchesForLeague(ctx, watchLeagueID)
+		}}, nil
+
+	case watchFavourites:
+		if len(settings.FavouriteTeams) == 0 {
+			return nil, errors.New("no favourite teams: pick some in settings or with f on a match")
+		}
+		return scopedProvider{Provider: client, live: func(ctx context.Context, force bool) ([]api.Match, error) {
+			return favouritesLive(ctx, client, settings, force)
+		}}, nil
+	}
+	return client, nil
+}
+
+// favouritesLive lists the live matches of the favourite teams, from the
+// leagues they were picked in (the selected leagues for teams without any).
+// Leagues that fail are left out until the next poll, unless all of them do.
+func favouritesLive(ctx context.Context, client api.Provider, settings *data.Settings, force bool) ([]api.Match, error) {
+	var matches []api.Match
+	leagues := settings.FavouriteLeagueIDs()
+	if len(leagues) == 0 {
+		var err error
+		if force {
+			matches, err = client.LiveMatchesForceRefresh(ctx)
+		} else {
+			matches, err = client.LiveMatches(ctx)
+		}
+		if partial, ok := api.AsPartialResult(err); err != nil && (!ok || partial.AllFailed()) {
+			return nil, err
+		}
+	} else {
+		var errs []error
+		for _, id := range leagues {
+			live, err := client.LiveMatchesForLeague(ctx, id)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			matches = append(matches, live...)
+		}
+		if len(errs) == len(leagues) {
+			return nil, errors.Join(errs...)
+		}
+	}
+
+	var favourites []api.Match
+	for _, match := range matches {
+		if settings.IsFavourite(match.HomeTeam.ID, match.AwayTeam.ID) {
+			favourites = append(favourites, match)
+		}
+	}
+	return favourites, nil
+}
+
+// streamEvents polls until full-time or ctx is cancelled, writing the events
+// of each poll as JSON lines. Failed polls are reported on stderr and retried.
+func streamEvents(ctx context.Context, client api.Provider, interval time.Duration) error {
+	watcher := watch.New(client, interval)
+	enc := json.NewEncoder(os.Stdout)
+	live := make(map[int]bool) // Matches being played
+	finished := false          // Whether a match seen live has finished
+
+	for {
+		update := watcher.Poll(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if update.Err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", update.Err)
+		}
+
+		for _, match := range update.Matches {
+			for _, event := range watch.Events(match, data.Now()) {
+				if err := enc.Encode(event); err != nil {
+					return err
+				}
+			}
+
+			switch match.Details.Status {
+			case api.MatchStatusLive:
+				live[match.Details.ID] = true
+			case api.MatchStatusNotStarted:
+			default:
+				if live[match.Details.ID] || match.Details.ID == watchMatchID {
+					finished = true
+				}
+				delete(live, match.Details.ID)
+			}
+		}
+		if finished && len(live) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(update.Next):
+		}
+	}
+}
+
+func init() {
+	watchCmd.Flags().IntVar(&watchMatchID, "match", 0, "Watch the match with this `ID` (as listed by live, results and fixtures)")
+	watchCmd.Flags().IntVar(&watchLeagueID, "league", 0, "Watch the live matches of the league with this `ID`")
+	watchCmd.Flags().BoolVar(&watchFavourites, "favourites", false, "Watch the matches of your favourite teams")
+	watchCmd.MarkFlagsMutuallyExclusive("match", "league", "favourites")
+	addProviderFlags(watchCmd)
+	rootCmd.AddCommand(watchCmd)
+}
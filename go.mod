@@ -10,6 +10,8 @@ require (
 	github.com/goforj/godump v1.9.0
 	github.com/lucasb-eyer/go-colorful v1.2.0
 	github.com/spf13/cobra v1.8.0
+	golang.org/x/sync v0.11.0
+	golang.org/x/sys v0.39.0
 	golang.org/x/term v0.38.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -43,7 +45,5 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/tadvi/systray v0.0.0-20190226123456-11a2b8fa57af // indirect
 	github.com/xo/terminfo v0.0.0-20220910002029-abceb7e1c41e // indirect
-	golang.org/x/sync v0.11.0 // indirect
-	golang.org/x/sys v0.39.0 // indirect
 	golang.org/x/text v0.3.8 // indirect
 )
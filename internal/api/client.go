@@ -11,15 +11,84 @@ type Client interface {
 	// MatchesByDate retrieves all matches for a specific date.
 	MatchesByDate(ctx context.Context, date time.Time) ([]Match, error)
 
-	// MatchDetails retrieves detailed information about a specific match.
+	// ResultsByDate retrieves the matches of a date that can have finished.
+	// Providers may skip upcoming fixtures, making it cheaper than MatchesByDate for past days.
+	ResultsByDate(ctx context.Context, date time.Time) ([]Match, error)
+
+	// MatchDetails retrieves detailed information about a specific match
+	// (nil, without an error, when there is no such match).
 	MatchDetails(ctx context.Context, matchID int) (*MatchDetails, error)
 
 	// Leagues retrieves available leagues.
 	Leagues(ctx context.Context) ([]League, error)
 
-	// LeagueMatches retrieves matches for a specific league.
+	// LeagueMatches retrieves all fixtures and results of a league's current season.
 	LeagueMatches(ctx context.Context, leagueID int) ([]Match, error)
 
 	// LeagueTable retrieves the league table/standings for a specific league.
 	LeagueTable(ctx context.Context, leagueID int) ([]LeagueTableEntry, error)
 }
+
+// LiveClient is implemented by providers that follow matches in progress.
+type LiveClient interface {
+	// LiveMatches retrieves all matches currently in progress (may be cached).
+	LiveMatches(ctx context.Context) ([]Match, error)
+
+	// LiveMatchesForceRefresh retrieves live matches, bypassing any cache.
+	LiveMatchesForceRefresh(ctx context.Context) ([]Match, error)
+
+	// LiveMatchesForLeague retrieves live matches for a single league (progressive loading).
+	LiveMatchesForLeague(ctx context.Context, leagueID int) ([]Match, error)
+
+	// MatchDetailsForceRefresh retrieves match details, bypassing any cache (polling).
+	MatchDetailsForceRefresh(ctx context.Context, matchID int) (*MatchDetails, error)
+}
+
+// SeasonClient is implemented by providers that can browse league seasons.
+type SeasonClient interface {
+	// LeagueSeason retrieves every fixture and result of a league season
+	// ("" = current season). Season names come from LeagueSeason.Seasons.
+	LeagueSeason(ctx context.Context, leagueID int, season string) (*LeagueSeason, error)
+}
+
+// Provider is a complete data source for the app.
+// League IDs are FotMob's throughout (they are what settings.yaml stores);
+// other providers map them to their own competition identifiers.
+type Provider interface {
+	Client
+	LiveClient
+	SeasonClient
+}
+
+// LiveMatchesStore is optionally implemented by providers that cache live
+// matches, so a list loaded league by league can be stored once complete.
+type LiveMatchesStore interface {
+	StoreLiveMatches(matches []Match)
+}
+
+// PollIntervalHint is optionally implemented by providers whose matches move
+// at a different pace than real ones (the mock simulation), to set how often
+// live match details are polled. Other refreshes are scaled to match.
+type PollIntervalHint interface {
+	PollInterval() time.Duration
+}
+
+// Remote is optionally implemented by providers relaying a golazo daemon,
+// which polls the live matches and sends the notifications itself.
+type Remote interface {
+	// Address returns where the daemon listens (its socket path).
+	Address() string
+}
+
+// TeamSearcher is optionally implemented by providers that can look teams up
+// by name (picking favourite teams in settings).
+type TeamSearcher interface {
+	SearchTeams(ctx context.Context, query string) ([]TeamSearchResult, error)
+}
+
+// TeamSearchResult is a team found by name, with the league it plays in
+// (zero when unknown).
+type TeamSearchResult struct {
+	Team   Team
+	League League
+}
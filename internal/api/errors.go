@@ -0,0 +1,96 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FailureKind classifies why a league request failed.
+type FailureKind string
+
+const (
+	FailureRequest     FailureKind = "request"     // Request could not be built
+	FailureTimeout     FailureKind = "timeout"     // Deadline exceeded or network timeout
+	FailureTransport   FailureKind = "transport"   // Connection/DNS/TLS errors
+	FailureStatus      FailureKind = "status"      // Non-200 HTTP status (after retries)
+	FailureSchema      FailureKind = "schema"      // Response body didn't match the expected JSON
+	FailureUnsupported FailureKind = "unsupported" // The provider doesn't cover this league
+)
+
+// LeagueError describes a failed request for one league and tab.
+// Tab is provider-specific (e.g., FotMob's "fixtures"/"results"); it may be empty.
+type LeagueError struct {
+	LeagueID   int
+	Tab        string
+	Kind       FailureKind
+	StatusCode int // Set when Kind is FailureStatus
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *LeagueError) Error() string {
+	if e.Kind == FailureStatus {
+		return fmt.Sprintf("league %d (%s): HTTP %d", e.LeagueID, e.Tab, e.StatusCode)
+	}
+	return fmt.Sprintf("league %d (%s): %s: %v", e.LeagueID, e.Tab, e.Kind, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *LeagueError) Unwrap() error {
+	return e.Err
+}
+
+// PartialResultError is returned alongside the matches that did load when
+// one or more league requests for a date failed.
+type PartialResultError struct {
+	Date     string         // Requested date (YYYY-MM-DD, UTC)
+	Requests int            // League requests attempted
+	Failures []*LeagueError // One entry per failed league+tab
+}
+
+// Error implements the error interface.
+func (e *PartialResultError) Error() string {
+	parts := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		parts[i] = failure.Error()
+	}
+	return fmt.Sprintf("%d of %d league requests failed for %s: %s",
+		len(e.Failures), e.Requests, e.Date, strings.Join(parts, "; "))
+}
+
+// Unwrap returns the individual league errors (for errors.Is/As).
+func (e *PartialResultError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure
+	}
+	return errs
+}
+
+// AllFailed reports whether every league request failed (no usable data).
+func (e *PartialResultError) AllFailed() bool {
+	return len(e.Failures) >= e.Requests
+}
+
+// FailedLeagues returns the distinct league IDs that had at least one failure.
+func (e *PartialResultError) FailedLeagues() []int {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, failure := range e.Failures {
+		if !seen[failure.LeagueID] {
+			seen[failure.LeagueID] = true
+			ids = append(ids, failure.LeagueID)
+		}
+	}
+	return ids
+}
+
+// AsPartialResult returns the PartialResultError in err's chain, if any.
+func AsPartialResult(err error) (*PartialResultError, bool) {
+	var partial *PartialResultError
+	if errors.As(err, &partial) {
+		return partial, true
+	}
+	return nil, false
+}
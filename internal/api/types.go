@@ -118,6 +118,42 @@ type MatchDetails struct {
 	AwayXG *float64 `json:"away_xg,omitempty"` // Expected goals for away team
 }
 
+// LeagueSeason holds every fixture and result of one league season.
+type LeagueSeason struct {
+	League  League   `json:"league"`
+	Season  string   `json:"season"`  // Season shown, e.g., "2024/2025" or "2022"
+	Seasons []string `json:"seasons"` // All seasons available for the league, newest first
+	Matches []Match  `json:"matches"` // Fixtures and results, ordered by kickoff
+}
+
+// LeagueRound is a matchday/round and its matches.
+type LeagueRound struct {
+	Name    string  `json:"name"`
+	Matches []Match `json:"matches"`
+}
+
+// Rounds groups the season's matches by round, in order of first kickoff.
+// Matches without a round are grouped by kickoff date instead.
+func (s *LeagueSeason) Rounds() []LeagueRound {
+	var rounds []LeagueRound
+	index := make(map[string]int)
+
+	for _, match := range s.Matches {
+		name := match.Round
+		if name == "" && match.MatchTime != nil {
+			name = match.MatchTime.Format("Mon 2 Jan 2006")
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(rounds)
+			index[name] = i
+			rounds = append(rounds, LeagueRound{Name: name})
+		}
+		rounds[i].Matches = append(rounds[i].Matches, match)
+	}
+	return rounds
+}
+
 // LeagueTableEntry represents a team's position in the league table
 type LeagueTableEntry struct {
 	Position       int  `json:"position"`
@@ -130,4 +166,12 @@ type LeagueTableEntry struct {
 	GoalsAgainst   int  `json:"goals_against"`
 	GoalDifference int  `json:"goal_difference"`
 	Points         int  `json:"points"`
+
+	// Competitions with several tables (UCL league phase, World Cup groups)
+	// label each entry with the table it belongs to. Empty for single-table leagues.
+	Group string `json:"group,omitempty"`
+
+	// Qualification/relegation zone the position falls into, if any.
+	Zone      string `json:"zone,omitempty"`       // e.g., "Champions League", "Relegation"
+	ZoneColor string `json:"zone_color,omitempty"` // Hex color provided by the API (e.g., "#2AD572")
 }
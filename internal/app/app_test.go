@@ -0,0 +1,424 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/fotmobfake"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	arsenal = fotmobfake.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}
+	chelsea = fotmobfake.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"}
+	everton = fotmobfake.Team{ID: 8668, Name: "Everton", ShortName: "Everton"}
+)
+
+// recordingNotifier stands in for desktop notifications.
+type recordingNotifier struct {
+	sent []notify.Notification
+}
+
+func (n *recordingNotifier) Notify(notification notify.Notification) error {
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+// newTestModel returns a model backed by a fake FotMob server with the
+// Premier League selected, and the notifier it reports to.
+func newTestModel(t *testing.T) (model, *fotmobfake.Server, *recordingNotifier) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	if err := data.SaveSettings(&data.Settings{SelectedLeagues: []int{47}, Timezone: "UTC"}); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+	t.Cleanup(func() { data.ApplyTimeSettings(nil) })
+
+	fake := fotmobfake.New()
+	t.Cleanup(fake.Close)
+	fake.AddLeague(fotmobfake.League{ID: 47, Name: "Premier League", Country: "England", CountryCode: "ENG"})
+
+	m := New(fotmob.NewClientWithTransport(fake.Transport()))
+	notifier := &recordingNotifier{}
+	m.notifier = notifier
+	return m, fake, notifier
+}
+
+// update runs cmd synchronously and feeds its message to the model.
+func update(t *testing.T, m model, cmd tea.Cmd) model {
+	t.Helper()
+	updated, _ := m.Update(cmd())
+	next, ok := updated.(model)
+	if !ok {
+		t.Fatalf("Update returned %T", updated)
+	}
+	return next
+}
+
+func today(hour int) time.Time {
+	now := data.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+}
+
+func TestLiveMatchFlow(t *testing.T) {
+	m, fake, notifier := newTestModel(t)
+	match := fake.AddMatch(47, 100, arsenal, chelsea, today(15)).Kickoff().At(12).Goal(fotmobfake.Home, "Saka", "")
+	fake.AddMatch(47, 101, everton, arsenal, today(20))
+
+	m.currentView = viewLiveMatches
+	m = update(t, m, fetchLiveBatchData(m.client, 0))
+	if len(m.matches) != 1 || m.matches[0].ID != match.ID() {
+		t.Fatalf("live list = %+v, want only match %d", m.matches, match.ID())
+	}
+
+	m = update(t, m, fetchMatchDetails(m.client, match.ID()))
+	if m.matchDetails == nil || !m.polling {
+		t.Fatalf("details = %v, polling = %v; want details and polling", m.matchDetails, m.polling)
+	}
+	if len(m.liveUpdates) != 1 {
+		t.Errorf("got %d live updates, want 1", len(m.liveUpdates))
+	}
+	m = update(t, m, pollWatcher(m.watcher))
+	if len(notifier.sent) != 0 {
+		t.Errorf("initial load sent %d notifications, want 0", len(notifier.sent))
+	}
+
+	match.At(40).Goal(fotmobfake.Away, "Palmer", "James")
+	m = update(t, m, pollWatcher(m.watcher))
+	if len(notifier.sent) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifier.sent))
+	}
+	got := notifier.sent[0]
+	if got.Event != data.NotifyGoal || !strings.Contains(got.Message, "Palmer") || *got.Match.HomeScore != 1 || *got.Match.AwayScore != 1 {
+		t.Errorf("unexpected notification: %+v", got)
+	}
+	if score := m.matches[0].AwayScore; score == nil || *score != 1 {
+		t.Errorf("live list away score = %v, want 1", score)
+	}
+
+	// The details panel reads the watcher's poll from the cache
+	requests := fake.Requests(fotmobfake.EndpointMatchDetails)
+	m = update(t, m, fetchPollMatchDetails(m.client, match.ID()))
+	if n := fake.Requests(fotmobfake.EndpointMatchDetails) - requests; n != 0 {
+		t.Errorf("panel poll made %d details requests, want 0", n)
+	}
+	if len(m.liveUpdates) != 2 || len(notifier.sent) != 1 {
+		t.Errorf("after panel poll: %d live updates, %d notifications; want 2 and 1", len(m.liveUpdates), len(notifier.sent))
+	}
+
+	// Polls without a new goal stay quiet; the second half starting is listed
+	match.At(55).Card(fotmobfake.Home, "Rice", fotmobfake.CardYellow)
+	m = update(t, m, pollWatcher(m.watcher))
+	m = update(t, m, fetchPollMatchDetails(m.client, match.ID()))
+	if len(notifier.sent) != 1 || len(m.liveUpdates) != 4 {
+		t.Errorf("after card: %d notifications, %d live updates; want 1 and 4", len(notifier.sent), len(m.liveUpdates))
+	}
+	if !strings.Contains(m.liveUpdates[1], "[SECOND HALF]") {
+		t.Errorf("live updates = %q, want the second half start after the card", m.liveUpdates)
+	}
+
+	match.FullTime()
+	m = update(t, m, pollWatcher(m.watcher))
+	m = update(t, m, fetchPollMatchDetails(m.client, match.ID()))
+	if m.polling {
+		t.Error("still polling after full time")
+	}
+}
+
+func TestWatcherNotifiesOtherMatches(t *testing.T) {
+	m, fake, notifier := newTestModel(t)
+	open := fake.AddMatch(47, 100, arsenal, chelsea, today(15)).Kickoff().At(20)
+	other := fake.AddMatch(47, 101, everton, fotmobfake.Team{ID: 8650, Name: "Liverpool", ShortName: "Liverpool"}, today(15)).Kickoff().At(20)
+
+	m.currentView = viewLiveMatches
+	m = update(t, m, fetchLiveBatchData(m.client, 0))
+	m = update(t, m, fetchMatchDetails(m.client, open.ID()))
+	m = update(t, m, pollWatcher(m.watcher))
+
+	other.At(30).Goal(fotmobfake.Away, "Salah", "")
+	m = update(t, m, pollWatcher(m.watcher))
+	if len(notifier.sent) != 1 || !strings.Contains(notifier.sent[0].Message, "Salah") {
+		t.Fatalf("notifications = %+v, want Salah's goal", notifier.sent)
+	}
+	for _, match := range m.matches {
+		if match.ID == other.ID() && (match.AwayScore == nil || *match.AwayScore != 1) {
+			t.Errorf("live list score for match %d = %v, want 1", other.ID(), match.AwayScore)
+		}
+	}
+	if m.matchDetails.ID != open.ID() {
+		t.Errorf("details panel switched to match %d", m.matchDetails.ID)
+	}
+}
+
+func TestNotificationRulesFromSettings(t *testing.T) {
+	m, fake, notifier := newTestModel(t)
+	followed := fake.AddMatch(47, 100, arsenal, chelsea, today(15)).Kickoff().At(80)
+	other := fake.AddMatch(47, 101, everton, fotmobfake.Team{ID: 8650, Name: "Liverpool", ShortName: "Liverpool"}, today(15)).Kickoff().At(80)
+
+	settings := &data.Settings{SelectedLeagues: []int{47}, Timezone: "UTC"}
+	settings.Notifications.Rules = []data.NotificationRule{
+		{Events: []data.NotificationEvent{data.NotifyFullTime}, Teams: []string{"chelsea"}},
+	}
+	if err := data.SaveSettings(settings); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+	m = New(m.client)
+	m.notifier = notifier
+
+	m = update(t, m, pollWatcher(m.watcher))
+	followed.At(85).Goal(fotmobfake.Home, "Saka", "")
+	other.At(85).Goal(fotmobfake.Home, "Calvert-Lewin", "")
+	m = update(t, m, pollWatcher(m.watcher))
+	if len(notifier.sent) != 0 {
+		t.Errorf("goals sent %d notifications with only full-time turned on", len(notifier.sent))
+	}
+
+	followed.FullTime()
+	other.FullTime()
+	update(t, m, pollWatcher(m.watcher))
+	if len(notifier.sent) != 1 || notifier.sent[0].Event != data.NotifyFullTime || notifier.sent[0].Match.ID != followed.ID() {
+		t.Errorf("notifications = %+v, want full-time of match %d only", notifier.sent, followed.ID())
+	}
+}
+
+// attachedClient stands in for a daemon's provider.
+type attachedClient struct {
+	api.Provider
+}
+
+func (attachedClient) Address() string {
+	return "daemon.sock"
+}
+
+func TestAttachedLeavesNotificationsToDaemon(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	match := fake.AddMatch(47, 100, arsenal, chelsea, today(15)).Kickoff().At(20)
+
+	m = New(attachedClient{m.client})
+	if m.notifier != nil {
+		t.Fatalf("notifier = %T, want none when attached to a daemon", m.notifier)
+	}
+	m = update(t, m, pollWatcher(m.watcher))
+	match.At(30).Goal(fotmobfake.Home, "Saka", "")
+	update(t, m, pollWatcher(m.watcher))
+}
+
+func TestFailedPollClearsDetails(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	match := fake.AddMatch(47, 200, arsenal, chelsea, today(15)).Kickoff()
+
+	m.currentView = viewLiveMatches
+	m = update(t, m, fetchMatchDetails(m.client, match.ID()))
+	if m.matchDetails == nil {
+		t.Fatal("no details after the first load")
+	}
+
+	// The watcher's refresh fails, so the panel's poll misses the cache and fails too
+	fake.Inject(fotmobfake.EndpointMatchDetails, fotmobfake.FaultMalformedJSON, 2)
+	m = update(t, m, pollWatcher(m.watcher))
+	m = update(t, m, fetchPollMatchDetails(m.client, match.ID()))
+	if m.matchDetails != nil {
+		t.Error("kept stale details after a failed poll")
+	}
+}
+
+func TestStatsDayFlow(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	fake.AddMatch(47, 300, arsenal, chelsea, today(12)).Kickoff().Goal(fotmobfake.Home, "Saka", "").FullTime()
+	fake.AddMatch(47, 301, everton, arsenal, today(20))
+	fake.AddMatch(47, 302, chelsea, everton, today(15)).Kickoff()
+
+	m.currentView = viewStats
+	m.statsTotalDays = 1
+	m = update(t, m, fetchStatsDayData(m.client, 0, 1))
+
+	if m.statsData == nil {
+		t.Fatal("no stats data")
+	}
+	if n := len(m.statsData.TodayFinished); n != 1 || m.statsData.TodayFinished[0].ID != 300 {
+		t.Errorf("today finished = %+v, want match 300", m.statsData.TodayFinished)
+	}
+	if n := len(m.statsData.TodayUpcoming); n != 1 || m.statsData.TodayUpcoming[0].ID != 301 {
+		t.Errorf("today upcoming = %+v, want match 301", m.statsData.TodayUpcoming)
+	}
+	if m.statsFailedDays != 0 || len(m.statsFailedLeagues) != 0 {
+		t.Errorf("failed days = %d, failed leagues = %v", m.statsFailedDays, m.statsFailedLeagues)
+	}
+}
+
+func TestStatsDayFlowRecordsFailedLeagues(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	fake.Inject(fotmobfake.EndpointLeagues, fotmobfake.FaultMalformedJSON, 2)
+
+	m.currentView = viewStats
+	m.statsTotalDays = 1
+	m = update(t, m, fetchStatsDayData(m.client, 0, 1))
+
+	if !m.statsFailedLeagues[47] {
+		t.Errorf("failed leagues = %v, want 47", m.statsFailedLeagues)
+	}
+}
+
+// press sends a key to the model, returning the command it starts.
+func press(t *testing.T, m model, key string) (model, tea.Cmd) {
+	t.Helper()
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	switch key {
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case " ":
+		msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(key)}
+	}
+	updated, cmd := m.Update(msg)
+	next, ok := updated.(model)
+	if !ok {
+		t.Fatalf("Update returned %T", updated)
+	}
+	return next, cmd
+}
+
+func TestQuitWaitsForQueuedNotifications(t *testing.T) {
+	m, _, _ := newTestModel(t)
+
+	var received atomic.Int32
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		received.Add(1)
+	}))
+	t.Cleanup(webhook.Close)
+	dispatcher, err := notify.FromSettings(data.NotificationSettings{
+		Backends: []data.NotificationBackend{{Type: notify.BackendWebhook, URL: webhook.URL}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("dispatcher: %v", err)
+	}
+	m.dispatcher = dispatcher
+	if err := dispatcher.Notify(notify.Sample()); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	if _, cmd := press(t, m, "q"); cmd == nil {
+		t.Fatal("q didn't quit")
+	}
+	if n := received.Load(); n != 1 {
+		t.Errorf("webhook received %d notifications on quit, want 1", n)
+	}
+}
+
+func TestQuitSendsHeldNotifications(t *testing.T) {
+	m, _, notifier := newTestModel(t)
+	settings := data.NotificationSettings{RateLimit: data.RateLimit{PerMatch: 1, Window: time.Hour}}
+	m.notifier = notify.NewThrottle(notifier, settings, data.NewMemoryNotifiedLog())
+
+	for i := 1; i <= 2; i++ {
+		home, away := i, 0
+		_ = m.notifier.Notify(notify.Notification{
+			Event: data.NotifyGoal,
+			Match: api.Match{ID: 100, HomeTeam: api.Team{ShortName: "ARS"}, AwayTeam: api.Team{ShortName: "CHE"}, HomeScore: &home, AwayScore: &away},
+			Title: "Goal",
+			Key:   fmt.Sprintf("goal:%d", i),
+		})
+	}
+	if len(notifier.sent) != 1 {
+		t.Fatalf("sent %d notifications before quitting, want 1 and one held back", len(notifier.sent))
+	}
+
+	press(t, m, "q")
+	if len(notifier.sent) != 2 || notifier.sent[1].Key != "goal:2" {
+		t.Errorf("sent %+v on quit, want the held goal last", notifier.sent)
+	}
+}
+
+func TestFavouritesArePinned(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	fake.AddMatch(47, 400, chelsea, everton, today(15)).Kickoff()
+	fake.AddMatch(47, 401, everton, arsenal, today(15)).Kickoff()
+
+	m.currentView = viewLiveMatches
+	m = update(t, m, fetchLiveBatchData(m.client, 0))
+	if len(m.matches) != 2 || m.matches[0].ID != 400 {
+		t.Fatalf("live list = %+v, want matches 400 and 401", m.matches)
+	}
+
+	// Away team of the second row
+	m.liveMatchesList.Select(1)
+	m, _ = press(t, m, "F")
+	if len(m.matches) != 2 || m.matches[0].ID != 401 || !m.matches[0].AwayFavourite {
+		t.Fatalf("live list = %+v, want Arsenal's match pinned first", m.matches)
+	}
+	if title := m.matches[0].Title(); title != "Everton vs ★ Arsenal" {
+		t.Errorf("title = %q, want Arsenal starred", title)
+	}
+	if item, ok := m.liveMatchesList.SelectedItem().(ui.MatchListItem); !ok || item.Match.ID != 401 {
+		t.Errorf("selection moved off the favourite's match")
+	}
+	settings, _ := data.LoadSettings()
+	if len(settings.FavouriteTeams) != 1 || settings.FavouriteTeams[0].ID != arsenal.ID || settings.FavouriteTeams[0].Leagues[0] != 47 {
+		t.Errorf("favourites = %+v, want Arsenal in the Premier League", settings.FavouriteTeams)
+	}
+
+	m, cmd := press(t, m, "*")
+	if len(m.matches) != 1 || m.matches[0].ID != 401 {
+		t.Errorf("favourites only lists %+v, want Arsenal's match", m.matches)
+	}
+	if m = update(t, m, cmd); len(m.matches) != 1 {
+		t.Errorf("after the reload, favourites only lists %+v", m.matches)
+	}
+	if settings, _ := data.LoadSettings(); !settings.FavouritesOnly {
+		t.Error("favourites only wasn't saved")
+	}
+}
+
+func TestFavouritesOnlyFollowsTheirLeagues(t *testing.T) {
+	m, fake, _ := newTestModel(t)
+	fake.AddLeague(fotmobfake.League{ID: 42, Name: "Champions League", Country: "Europe", CountryCode: "INT"})
+	realMadrid := fotmobfake.Team{ID: 8633, Name: "Real Madrid", ShortName: "Real Madrid"}
+	fake.AddMatch(42, 500, realMadrid, arsenal, today(12)).Kickoff().FullTime()
+	fake.AddMatch(47, 501, chelsea, everton, today(12)).Kickoff().FullTime()
+
+	// Pick Arsenal from the team search in settings
+	m.currentView = viewSettings
+	m.settingsState = ui.NewSettingsState()
+	m, _ = press(t, m, "f")
+	m, _ = press(t, m, "/")
+	for _, key := range []string{"a", "r", "s"} {
+		m, _ = press(t, m, key)
+	}
+	if query := m.settingsState.Search.Value(); query != "ars" {
+		t.Fatalf("search box = %q, want the typed query", query)
+	}
+	m, cmd := press(t, m, "enter")
+	if cmd == nil {
+		t.Fatal("no search started")
+	}
+	m = update(t, m, cmd)
+	m, _ = press(t, m, " ")
+	m, _ = press(t, m, "enter")
+
+	settings, _ := data.LoadSettings()
+	if len(settings.FavouriteTeams) != 1 || settings.FavouriteTeams[0].Name != "Arsenal" {
+		t.Fatalf("favourites = %+v, want Arsenal", settings.FavouriteTeams)
+	}
+
+	// Only the Premier League is selected, but Arsenal played in Europe
+	m.currentView = viewStats
+	m.statsTotalDays = 1
+	m, _ = press(t, m, "*")
+	m = update(t, m, fetchStatsDayData(m.client, 0, 1))
+	if len(m.matches) != 1 || m.matches[0].ID != 500 {
+		t.Errorf("favourites only lists %+v, want Arsenal's Champions League match", m.matches)
+	}
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+// applyMatchChanges adds the status changes and disallowed goals between two
+// polls of the open match to the timeline shown with the live updates. Goals,
+// cards and substitutions are already in the match events; notifications come
+// from the background watcher, which covers every live match.
+func (m *model) applyMatchChanges(details *api.MatchDetails, changes []matchdiff.Change) {
+	for _, change := range changes {
+		switch c := change.(type) {
+		case matchdiff.GoalDisallowed:
+			entry := api.MatchEvent{Type: fotmob.EventTypeDisallowed, Team: c.Team, Minute: latestMinute(details)}
+			if c.Goal != nil {
+				entry.Minute = c.Goal.Minute
+				entry.Player = c.Goal.Player
+			}
+			m.addTimelineEntry(entry)
+
+		case matchdiff.StatusChanged:
+			name := c.To.String()
+			if c.From == matchdiff.PhaseNotStarted && c.To != matchdiff.PhaseFinished {
+				name = "Kick-off"
+			}
+			m.addTimelineEntry(api.MatchEvent{
+				Type:   fotmob.EventTypeStatus,
+				Minute: phaseMinute(c.To, details),
+				Player: &name,
+			})
+		}
+	}
+}
+
+// addTimelineEntry adds an entry ahead of the older ones, so the live updates
+// list it above earlier entries and events of the same minute.
+func (m *model) addTimelineEntry(entry api.MatchEvent) {
+	m.timeline = append([]api.MatchEvent{entry}, m.timeline...)
+}
+
+// phaseMinute returns the minute a phase starts at, for ordering the
+// timeline among the match events.
+func phaseMinute(p matchdiff.Phase, details *api.MatchDetails) int {
+	switch p {
+	case matchdiff.PhaseHalfTime, matchdiff.PhaseSecondHalf:
+		return 45
+	case matchdiff.PhaseExtraTime:
+		return 90
+	case matchdiff.PhasePenalties:
+		return 120
+	case matchdiff.PhaseFinished:
+		return max(90, latestMinute(details))
+	}
+	return 0
+}
+
+// latestMinute returns the minute of the latest match event.
+func latestMinute(details *api.MatchDetails) int {
+	minute := 0
+	for _, event := range details.Events {
+		minute = max(minute, event.Minute)
+	}
+	return minute
+}
@@ -2,27 +2,27 @@ package app
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/watch"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // LiveRefreshInterval is the interval between automatic live matches list refreshes.
 const LiveRefreshInterval = 5 * time.Minute
 
+// PollInterval is the interval between match details polls of a live match.
+const PollInterval = 90 * time.Second
+
 // fetchLiveMatches fetches live matches from the API (used for cache check only now).
-// Returns mock data if useMockData is true, otherwise uses real API.
 // NOTE: For initial load, use fetchLiveLeagueData for progressive loading.
-func fetchLiveMatches(client *fotmob.Client, useMockData bool) tea.Cmd {
+func fetchLiveMatches(client api.Provider) tea.Cmd {
 	return func() tea.Msg {
-		if useMockData {
-			return liveMatchesMsg{matches: data.MockLiveMatches()}
-		}
-
 		if client == nil {
 			return liveMatchesMsg{matches: nil}
 		}
@@ -31,10 +31,11 @@ func fetchLiveMatches(client *fotmob.Client, useMockData bool) tea.Cmd {
 		defer cancel()
 
 		matches, err := client.LiveMatches(ctx)
-		if err != nil {
+		if _, partial := api.AsPartialResult(err); err != nil && !partial {
 			return liveMatchesMsg{matches: nil}
 		}
 
+		// Partial results still carry the leagues that loaded
 		return liveMatchesMsg{matches: matches}
 	}
 }
@@ -45,7 +46,7 @@ const LiveBatchSize = 4
 // fetchLiveBatchData fetches live matches for a batch of leagues concurrently.
 // batchIndex: 0, 1, 2, ... (each batch fetches LiveBatchSize leagues in parallel)
 // Results appear after each batch completes, giving progressive updates while being fast.
-func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int) tea.Cmd {
+func fetchLiveBatchData(client api.Provider, batchIndex int) tea.Cmd {
 	return func() tea.Msg {
 		totalLeagues := fotmob.TotalLeagues()
 		startIdx := batchIndex * LiveBatchSize
@@ -55,22 +56,6 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 		}
 		isLast := endIdx >= totalLeagues
 
-		if useMockData {
-			// Return mock data only on first batch
-			if batchIndex == 0 {
-				return liveBatchDataMsg{
-					batchIndex: batchIndex,
-					isLast:     isLast,
-					matches:    data.MockLiveMatches(),
-				}
-			}
-			return liveBatchDataMsg{
-				batchIndex: batchIndex,
-				isLast:     isLast,
-				matches:    nil,
-			}
-		}
-
 		if client == nil {
 			return liveBatchDataMsg{
 				batchIndex: batchIndex,
@@ -114,40 +99,47 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 	}
 }
 
-// scheduleLiveRefresh schedules the next live matches refresh after 5 minutes.
+// scheduleLiveRefresh schedules the next live matches refresh after interval
+// (LiveRefreshInterval unless the provider says otherwise).
 // This is used to keep the live matches list current while the user is in the view.
-func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
-	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
-		if useMockData {
-			return liveRefreshMsg{matches: data.MockLiveMatches()}
-		}
+func scheduleLiveRefresh(client api.Provider, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return refreshLiveMatches(client)
+	})
+}
 
-		if client == nil {
-			return liveRefreshMsg{matches: nil}
-		}
+// reloadLiveMatches refreshes the live matches right away (e.g., after the
+// active leagues changed), without scheduling another refresh.
+func reloadLiveMatches(client api.Provider) tea.Cmd {
+	return func() tea.Msg {
+		msg := refreshLiveMatches(client)
+		msg.reload = true
+		return msg
+	}
+}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+// refreshLiveMatches fetches the live matches, bypassing the cache.
+func refreshLiveMatches(client api.Provider) liveRefreshMsg {
+	if client == nil {
+		return liveRefreshMsg{matches: nil}
+	}
 
-		// Force refresh to bypass cache
-		matches, err := client.LiveMatchesForceRefresh(ctx)
-		if err != nil {
-			return liveRefreshMsg{matches: nil}
-		}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-		return liveRefreshMsg{matches: matches}
-	})
+	// Force refresh to bypass cache
+	matches, err := client.LiveMatchesForceRefresh(ctx)
+	partial, isPartial := api.AsPartialResult(err)
+	if err != nil && !isPartial {
+		return liveRefreshMsg{err: err}
+	}
+
+	return liveRefreshMsg{matches: matches, partial: partial}
 }
 
 // fetchMatchDetails fetches match details from the API.
-// Returns mock data if useMockData is true, otherwise uses real API.
-func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchMatchDetails(client api.Provider, matchID int) tea.Cmd {
 	return func() tea.Msg {
-		if useMockData {
-			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
-		}
-
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
@@ -160,10 +152,11 @@ func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea
 	}
 }
 
-// schedulePollTick schedules the next poll after 90 seconds.
+// schedulePollTick schedules the next poll after interval (PollInterval
+// unless the provider says otherwise).
 // When the tick fires, it sends pollTickMsg which triggers the actual API call.
-func schedulePollTick(matchID int) tea.Cmd {
-	return tea.Tick(90*time.Second, func(t time.Time) tea.Msg {
+func schedulePollTick(matchID int, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return pollTickMsg{matchID: matchID}
 	})
 }
@@ -180,19 +173,14 @@ func schedulePollSpinnerHide() tea.Cmd {
 
 // fetchPollMatchDetails fetches match details for a poll refresh.
 // This is called when pollTickMsg is received, with loading state visible.
-// Uses force refresh to bypass cache and ensure fresh data for live matches.
-func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// The background watcher force refreshes every live match, so the cache holds
+// details no older than its last poll; only a cache miss hits the API.
+func fetchPollMatchDetails(client api.Provider, matchID int) tea.Cmd {
 	return func() tea.Msg {
-		if useMockData {
-			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
-		}
-
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		// Force refresh to bypass cache - live matches need fresh data
-		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
+		details, err := client.MatchDetails(ctx, matchID)
 		if err != nil {
 			return matchDetailsMsg{details: nil}
 		}
@@ -201,34 +189,39 @@ func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool)
 	}
 }
 
+// WatchTimeout bounds a background poll of every live match.
+const WatchTimeout = 30 * time.Second
+
+// pollWatcher polls every live match once (see watch.Watcher).
+func pollWatcher(watcher *watch.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), WatchTimeout)
+		defer cancel()
+
+		return watchUpdateMsg{update: watcher.Poll(ctx)}
+	}
+}
+
+// scheduleWatch polls every live match again after interval, as chosen by
+// the watcher's previous poll.
+func scheduleWatch(watcher *watch.Watcher, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), WatchTimeout)
+		defer cancel()
+
+		return watchUpdateMsg{update: watcher.Poll(ctx)}
+	})
+}
+
 // fetchStatsDayData fetches stats data for a single day (progressive loading).
 // dayIndex: 0 = today, 1 = yesterday, etc.
 // totalDays: total number of days to fetch (for isLast calculation)
 // This enables showing results immediately as each day's data arrives.
-func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, totalDays int) tea.Cmd {
+func fetchStatsDayData(client api.Provider, dayIndex int, totalDays int) tea.Cmd {
 	return func() tea.Msg {
 		isToday := dayIndex == 0
 		isLast := dayIndex == totalDays-1
 
-		if useMockData {
-			if isToday {
-				return statsDayDataMsg{
-					dayIndex: dayIndex,
-					isToday:  true,
-					isLast:   isLast,
-					finished: data.MockFinishedMatches(),
-					upcoming: nil,
-				}
-			}
-			return statsDayDataMsg{
-				dayIndex: dayIndex,
-				isToday:  false,
-				isLast:   isLast,
-				finished: nil,
-				upcoming: nil,
-			}
-		}
-
 		if client == nil {
 			return statsDayDataMsg{
 				dayIndex: dayIndex,
@@ -242,8 +235,8 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		// Calculate the date for this day
-		today := time.Now().UTC()
+		// Calculate the date for this day (in the configured timezone)
+		today := data.Now()
 		date := today.AddDate(0, 0, -dayIndex)
 
 		var matches []api.Match
@@ -251,19 +244,21 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 
 		if isToday {
 			// Today: need both fixtures (upcoming) and results (finished)
-			matches, err = client.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
+			matches, err = client.MatchesByDate(ctx, date)
 		} else {
 			// Past days: only need results (finished matches)
-			matches, err = client.MatchesByDateWithTabs(ctx, date, []string{"results"})
+			matches, err = client.ResultsByDate(ctx, date)
 		}
 
-		if err != nil {
+		partial, isPartial := api.AsPartialResult(err)
+		if err != nil && !isPartial {
 			return statsDayDataMsg{
 				dayIndex: dayIndex,
 				isToday:  isToday,
 				isLast:   isLast,
 				finished: nil,
 				upcoming: nil,
+				err:      err,
 			}
 		}
 
@@ -283,18 +278,14 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 			isLast:   isLast,
 			finished: finished,
 			upcoming: upcoming,
+			partial:  partial,
 		}
 	}
 }
 
-// fetchStatsMatchDetailsFotmob fetches match details from FotMob API for stats view.
-func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// fetchStatsMatchDetails fetches match details for the stats view.
+func fetchStatsMatchDetails(client api.Provider, matchID int) tea.Cmd {
 	return func() tea.Msg {
-		if useMockData {
-			details, _ := data.MockFinishedMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
-		}
-
 		if client == nil {
 			return matchDetailsMsg{details: nil}
 		}
@@ -310,3 +301,94 @@ func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockDat
 		return matchDetailsMsg{details: details}
 	}
 }
+
+// fetchStandings fetches the league table for the standings view.
+func fetchStandings(client api.Provider, leagueID int) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return standingsMsg{leagueID: leagueID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		entries, err := client.LeagueTable(ctx, leagueID)
+		return standingsMsg{leagueID: leagueID, entries: entries, err: err}
+	}
+}
+
+// fetchLeagueSeason fetches a league season for the fixtures browser.
+// season is FotMob's season name ("" = current season).
+func fetchLeagueSeason(client api.Provider, leagueID int, season string) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return leagueSeasonMsg{leagueID: leagueID, season: season}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		result, err := client.LeagueSeason(ctx, leagueID, season)
+		return leagueSeasonMsg{leagueID: leagueID, season: season, result: result, err: err}
+	}
+}
+
+// fetchLeagueCatalog fetches the full league catalog for the settings view.
+// The client serves a fresh on-disk copy when available, so this is usually instant.
+func fetchLeagueCatalog(client api.Provider) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return leagueCatalogMsg{}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		leagues, err := client.Leagues(ctx)
+		if err != nil {
+			return leagueCatalogMsg{err: err}
+		}
+
+		infos := make([]data.LeagueInfo, len(leagues))
+		for i, league := range leagues {
+			infos[i] = data.LeagueInfo{ID: league.ID, Name: league.Name, Country: league.Country}
+		}
+		return leagueCatalogMsg{leagues: infos}
+	}
+}
+
+// searchTeams looks teams up by name for the favourites in settings.
+// Providers without a team search are searched through the loaded matches.
+func searchTeams(client api.Provider, query string, loaded []api.Match) tea.Cmd {
+	return func() tea.Msg {
+		searcher, ok := client.(api.TeamSearcher)
+		if !ok {
+			return teamSearchMsg{query: query, results: teamsInMatches(loaded, query)}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		results, err := searcher.SearchTeams(ctx, query)
+		return teamSearchMsg{query: query, results: results, err: err}
+	}
+}
+
+// teamsInMatches returns the teams of matches whose name contains query
+// (case-insensitive), each once, with the league it was first seen in.
+func teamsInMatches(matches []api.Match, query string) []api.TeamSearchResult {
+	query = strings.ToLower(query)
+	var results []api.TeamSearchResult
+	seen := make(map[int]bool)
+	for _, match := range matches {
+		for _, team := range []api.Team{match.HomeTeam, match.AwayTeam} {
+			name := strings.ToLower(team.Name + " " + team.ShortName)
+			if team.ID == 0 || seen[team.ID] || !strings.Contains(name, query) {
+				continue
+			}
+			seen[team.ID] = true
+			results = append(results, api.TeamSearchResult{Team: team, League: match.League})
+		}
+	}
+	return results
+}
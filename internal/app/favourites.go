@@ -0,0 +1,159 @@
+package app
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// favouriteTeamIDs returns the favourite teams from settings as a set.
+func favouriteTeamIDs(settings *data.Settings) map[int]bool {
+	ids := make(map[int]bool, len(settings.FavouriteTeams))
+	for _, team := range settings.FavouriteTeams {
+		ids[team.ID] = true
+	}
+	return ids
+}
+
+// displayMatches converts matches for the match lists: favourite teams are
+// starred and their matches pinned to the top, in the order given. In
+// favourites-only mode the other matches are left out.
+func (m model) displayMatches(matches []api.Match) []ui.MatchDisplay {
+	var favourites, others []ui.MatchDisplay
+	for _, match := range matches {
+		display := ui.MatchDisplay{
+			Match:         match,
+			HomeFavourite: m.favouriteTeams[match.HomeTeam.ID],
+			AwayFavourite: m.favouriteTeams[match.AwayTeam.ID],
+		}
+		if display.Favourite() {
+			favourites = append(favourites, display)
+		} else if !m.favouritesOnly {
+			others = append(others, display)
+		}
+	}
+	return append(favourites, others...)
+}
+
+// toggleFavourite adds the home or away team of the highlighted match row to
+// the favourite teams, or removes it, and re-sorts the list. A team added
+// from a row is followed in that match's league.
+func (m model) toggleFavourite(selected list.Item, home bool) (tea.Model, tea.Cmd) {
+	item, ok := selected.(ui.MatchListItem)
+	if !ok {
+		return m, nil
+	}
+	team := item.Match.AwayTeam
+	if home {
+		team = item.Match.HomeTeam
+	}
+	if team.ID == 0 {
+		return m, nil
+	}
+
+	settings, _ := data.LoadSettings()
+	settings.ToggleFavourite(data.FavouriteTeam{ID: team.ID, Name: team.Name, Leagues: []int{item.Match.League.ID}})
+	_ = data.SaveSettings(settings) // Best-effort save
+	m.favouriteTeams = favouriteTeamIDs(settings)
+
+	m.rearrangeMatches(item.Match.ID)
+	return m, nil
+}
+
+// toggleFavouritesOnly switches favourites-only mode and reloads the current
+// list, as the leagues fetched change with it. Ignored while the list loads.
+func (m model) toggleFavouritesOnly() (tea.Model, tea.Cmd) {
+	if m.liveViewLoading || m.statsViewLoading {
+		return m, nil
+	}
+
+	settings, _ := data.LoadSettings()
+	settings.FavouritesOnly = !settings.FavouritesOnly
+	_ = data.SaveSettings(settings) // Best-effort save
+	m.favouritesOnly = settings.FavouritesOnly
+	m.liveMatchesList.AdditionalShortHelpKeys = matchListHelpKeys(m.favouritesOnly)
+	m.statsMatchesList.AdditionalShortHelpKeys = matchListHelpKeys(m.favouritesOnly)
+
+	switch m.currentView {
+	case viewLiveMatches:
+		m.rearrangeMatches(0)
+		return m, reloadLiveMatches(m.client)
+	case viewStats:
+		m.statsViewLoading = true
+		m.loading = true
+		m.statsData = nil
+		m.statsDaysLoaded = 0
+		m.statsFailedLeagues = nil
+		m.statsFailedDays = 0
+		m.matchDetails = nil
+		m.statsMatchesList.SetItems([]list.Item{})
+		return m, tea.Batch(ui.SpinnerTick(), fetchStatsDayData(m.client, 0, m.statsTotalDays))
+	}
+	return m, nil
+}
+
+// rearrangeMatches re-applies the favourites to the current match list,
+// keeping matchID selected if it is still listed.
+func (m *model) rearrangeMatches(matchID int) {
+	switch m.currentView {
+	case viewLiveMatches:
+		m.matches = m.rearrangeDisplays(m.matches)
+		m.liveMatchesList.SetItems(ui.ToMatchListItems(m.matches))
+		m.liveUpcomingMatches = m.rearrangeDisplays(m.liveUpcomingMatches)
+		m.selectMatch(&m.liveMatchesList, matchID)
+	case viewStats:
+		m.applyStatsDateFilter()
+		m.selectMatch(&m.statsMatchesList, matchID)
+	}
+}
+
+// rearrangeDisplays re-applies the favourites to already converted matches.
+func (m model) rearrangeDisplays(displays []ui.MatchDisplay) []ui.MatchDisplay {
+	matches := make([]api.Match, len(displays))
+	for i, display := range displays {
+		matches[i] = display.Match
+	}
+	return m.displayMatches(matches)
+}
+
+// selectMatch moves a match list's cursor to a match, or to the top if it
+// isn't listed.
+func (m *model) selectMatch(l *list.Model, matchID int) {
+	m.selected = 0
+	l.Select(0)
+	for i, display := range m.matches {
+		if display.ID == matchID {
+			m.selected = i
+			l.Select(i)
+			return
+		}
+	}
+}
+
+// loadedMatches returns every match loaded so far, for the team search of
+// providers without one.
+func (m model) loadedMatches() []api.Match {
+	var matches []api.Match
+	for _, display := range m.matches {
+		matches = append(matches, display.Match)
+	}
+	for _, display := range m.liveUpcomingMatches {
+		matches = append(matches, display.Match)
+	}
+	matches = append(matches, m.liveMatchesBuffer...)
+	if m.statsData != nil {
+		matches = append(matches, m.statsData.AllFinished...)
+		matches = append(matches, m.statsData.TodayUpcoming...)
+	}
+	return matches
+}
+
+// handleTeamSearch shows the teams found for a search in the settings view.
+func (m model) handleTeamSearch(msg teamSearchMsg) (tea.Model, tea.Cmd) {
+	if m.settingsState != nil {
+		m.settingsState.SetSearchResults(msg.query, msg.results, msg.err)
+	}
+	return m, nil
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/charmbracelet/bubbles/list"
@@ -14,7 +15,7 @@ import (
 func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "j", "down":
-		if m.selected < 2 && !m.mainViewLoading { // 3 menu items: 0, 1, 2
+		if m.selected < 4 && !m.mainViewLoading { // 5 menu items: 0, 1, 2, 3, 4
 			m.selected++
 		}
 	case "k", "up":
@@ -26,11 +27,21 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		// Handle Settings view separately (no API calls needed)
+		// Handle Standings view separately (fetches its own data after switching)
 		if m.selected == 2 {
+			return m.openStandings(0, viewMain)
+		}
+
+		// Handle Fixtures view separately (fetches its own data after switching)
+		if m.selected == 3 {
+			return m.openFixtures(0, viewMain)
+		}
+
+		// Handle Settings view separately (only refreshes the league catalog)
+		if m.selected == 4 {
 			m.settingsState = ui.NewSettingsState()
 			m.currentView = viewSettings
-			return m, nil
+			return m, fetchLeagueCatalog(m.client)
 		}
 
 		m.mainViewLoading = true
@@ -41,9 +52,8 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.upcomingMatches = nil
 		m.matchDetails = nil
 		m.liveUpdates = nil
-		m.lastEvents = nil
-		m.lastHomeScore = 0
-		m.lastAwayScore = 0
+		m.lastDetails = nil
+		m.timeline = nil
 		m.polling = false
 		m.upcomingMatchesList.SetItems([]list.Item{})
 		m.matchDetailsCache = make(map[int]*api.MatchDetails)
@@ -58,13 +68,15 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case 0: // Stats view - fetch data progressively (day by day)
 			m.statsViewLoading = true
 			m.loading = true
-			m.statsData = nil                          // Clear cached data to force fresh fetch
-			m.statsDaysLoaded = 0                      // Reset progress
-			m.statsTotalDays = fotmob.StatsDataDays    // Set total days to load
+			m.statsData = nil                       // Clear cached data to force fresh fetch
+			m.statsDaysLoaded = 0                   // Reset progress
+			m.statsTotalDays = fotmob.StatsDataDays // Set total days to load
+			m.statsFailedLeagues = nil              // Clear previous load failures
+			m.statsFailedDays = 0
 			m.statsMatchesList.SetItems([]list.Item{}) // Clear list
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching day 0 (today) first - results shown immediately when it completes
-			cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+			cmds = append(cmds, fetchStatsDayData(m.client, 0, fotmob.StatsDataDays))
 		case 1: // Live Matches view - preload live matches progressively (parallel batches)
 			m.liveViewLoading = true
 			m.loading = true
@@ -75,7 +87,7 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.liveMatchesList.SetItems([]list.Item{})
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching batch 0 (4 leagues in parallel) - results shown when batch completes
-			cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, 0))
+			cmds = append(cmds, fetchLiveBatchData(m.client, 0))
 		}
 
 		return m, tea.Batch(cmds...)
@@ -155,20 +167,19 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.loading = true
 	m.statsDaysLoaded = 0
 	m.statsTotalDays = fotmob.StatsDataDays
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.client, 0, fotmob.StatsDataDays))
 }
 
 // loadMatchDetails loads match details for the live matches view.
 // Resets live updates and event history before fetching new details.
 func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
-	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.lastDetails = nil
+	m.timeline = nil
 	m.loading = true
 	m.liveViewLoading = true
 	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchMatchDetails(m.fotmobClient, matchID, m.useMockData))
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchMatchDetails(m.client, matchID))
 }
 
 // loadStatsMatchDetails loads match details for the stats view.
@@ -183,7 +194,7 @@ func (m model) loadStatsMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 	// Fetch from API
 	m.loading = true
 	m.statsViewLoading = true
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.fotmobClient, matchID, m.useMockData))
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetails(m.client, matchID))
 }
 
 // handleSettingsViewKeys processes keyboard input for the settings view.
@@ -193,6 +204,13 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	switch m.settingsState.Section {
+	case ui.SettingsNotifications:
+		return m.handleNotificationSettingsKeys(msg)
+	case ui.SettingsFavourites:
+		return m.handleFavouriteSettingsKeys(msg)
+	}
+
 	// Check if list is filtering - if so, let list handle ALL keys
 	isFiltering := m.settingsState.List.FilterState() == list.Filtering
 
@@ -202,13 +220,26 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case " ": // Space to toggle selection
 			m.settingsState.Toggle()
 			return m, nil
-		case "enter":
-			// Save settings and return to main menu
-			_ = m.settingsState.Save() // Best-effort save
-			m.settingsState = nil
-			m.currentView = viewMain
-			m.selected = 0
+		case "tab": // Jump to next country
+			m.settingsState.NextCountry()
+			return m, nil
+		case "shift+tab": // Jump to previous country
+			m.settingsState.PrevCountry()
+			return m, nil
+		case "z": // Cycle timezone
+			m.settingsState.CycleTimezone()
+			return m, nil
+		case "c": // Toggle 12h/24h clock
+			m.settingsState.ToggleClock()
+			return m, nil
+		case "n": // Switch to the notification rules
+			m.settingsState.ToggleSection(ui.SettingsNotifications)
+			return m, nil
+		case "f": // Switch to the favourite teams
+			m.settingsState.ToggleSection(ui.SettingsFavourites)
 			return m, nil
+		case "enter":
+			return m.saveSettings()
 		}
 	}
 
@@ -217,3 +248,218 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.settingsState.List, listCmd = m.settingsState.List.Update(msg)
 	return m, listCmd
 }
+
+// handleNotificationSettingsKeys processes keyboard input for the
+// notifications section of the settings view.
+func (m model) handleNotificationSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case " ": // Space to turn a rule or event on/off
+		m.settingsState.ToggleNotification()
+		return m, nil
+	case "tab": // Jump to next rule
+		m.settingsState.NextRule()
+		return m, nil
+	case "shift+tab": // Jump to previous rule
+		m.settingsState.PrevRule()
+		return m, nil
+	case "a":
+		m.settingsState.AddRule()
+		return m, nil
+	case "d":
+		m.settingsState.DeleteRule()
+		return m, nil
+	case "n": // Back to the leagues
+		m.settingsState.ToggleSection(ui.SettingsNotifications)
+		return m, nil
+	case "enter":
+		return m.saveSettings()
+	}
+
+	var listCmd tea.Cmd
+	m.settingsState.Notifications, listCmd = m.settingsState.Notifications.Update(msg)
+	return m, listCmd
+}
+
+// handleFavouriteSettingsKeys processes keyboard input for the favourites
+// section of the settings view: the favourite teams, the team search box and
+// its results.
+func (m model) handleFavouriteSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	state := m.settingsState
+
+	if state.Searching {
+		switch msg.String() {
+		case "enter":
+			if query := state.SubmitSearch(); query != "" {
+				return m, searchTeams(m.client, query, m.loadedMatches())
+			}
+			return m, nil
+		case "esc":
+			state.CancelSearch()
+			return m, nil
+		}
+		var inputCmd tea.Cmd
+		state.Search, inputCmd = state.Search.Update(msg)
+		return m, inputCmd
+	}
+
+	switch msg.String() {
+	case "/": // Search teams by name
+		state.StartSearch()
+		return m, nil
+	case " ": // Space to add or remove the highlighted team
+		state.ToggleFavourite()
+		return m, nil
+	case "esc": // Back from search results (Esc leaves settings otherwise)
+		state.ClearSearch()
+		return m, nil
+	case "f": // Back to the leagues
+		state.ToggleSection(ui.SettingsFavourites)
+		return m, nil
+	case "enter":
+		return m.saveSettings()
+	}
+
+	var listCmd tea.Cmd
+	state.Favourites, listCmd = state.Favourites.Update(msg)
+	return m, listCmd
+}
+
+// saveSettings saves the settings view and returns to the main menu. Saved
+// notification rules apply to the next background poll, favourite teams to
+// the next match list.
+func (m model) saveSettings() (tea.Model, tea.Cmd) {
+	_ = m.settingsState.Save() // Best-effort save
+	if settings, err := data.LoadSettings(); err == nil {
+		m.notificationRules = settings.NotificationRules()
+		m.favouriteTeams = favouriteTeamIDs(settings)
+	}
+	m.settingsState = nil
+	m.currentView = viewMain
+	m.selected = 0
+	return m, nil
+}
+
+// activeLeagueInfos returns metadata for the leagues selected in settings.
+func activeLeagueInfos() []data.LeagueInfo {
+	leagueIDs := data.GetActiveLeagueIDs()
+	leagues := make([]data.LeagueInfo, 0, len(leagueIDs))
+	for _, id := range leagueIDs {
+		if info, ok := data.LeagueInfoByID(id); ok {
+			leagues = append(leagues, info)
+		}
+	}
+	return leagues
+}
+
+// openStandings switches to the standings view using the leagues selected in settings.
+// focusLeagueID selects the initial league (0 = first selected league).
+// highlightTeams are team IDs to highlight, e.g. the teams of the selected match.
+func (m model) openStandings(focusLeagueID int, returnView view, highlightTeams ...int) (tea.Model, tea.Cmd) {
+	m.standingsState = ui.NewStandingsState(activeLeagueInfos(), focusLeagueID, highlightTeams...)
+	m.standingsReturnView = returnView
+	m.currentView = viewStandings
+	return m.loadStandings()
+}
+
+// openStandingsForSelectedMatch opens the standings for the league of the
+// currently displayed match, highlighting both teams.
+func (m model) openStandingsForSelectedMatch(returnView view) (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil {
+		return m, nil
+	}
+	details := m.matchDetails
+	return m.openStandings(details.League.ID, returnView, details.HomeTeam.ID, details.AwayTeam.ID)
+}
+
+// loadStandings starts fetching the table for the currently picked league.
+func (m model) loadStandings() (tea.Model, tea.Cmd) {
+	league := m.standingsState.CurrentLeague()
+	if league.ID == 0 {
+		return m, nil
+	}
+
+	m.standingsState.Loading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchStandings(m.client, league.ID))
+}
+
+// handleStandingsViewKeys processes keyboard input for the standings view.
+// Left/right cycle through the selected leagues, up/down scroll the table.
+func (m model) handleStandingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.standingsState == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "l", "right":
+		m.standingsState.NextLeague()
+		return m.loadStandings()
+	case "h", "left":
+		m.standingsState.PrevLeague()
+		return m.loadStandings()
+	case "j", "down":
+		m.standingsState.ScrollDown(1)
+	case "k", "up":
+		m.standingsState.ScrollUp(1)
+	case "pgdown", "f":
+		m.standingsState.ScrollDown(10)
+	case "pgup", "b":
+		m.standingsState.ScrollUp(10)
+	case "m":
+		return m.openFixtures(m.standingsState.CurrentLeague().ID, viewStandings)
+	}
+	return m, nil
+}
+
+// openFixtures switches to the fixtures browser using the leagues selected in settings.
+// focusLeagueID selects the initial league (0 = first selected league).
+func (m model) openFixtures(focusLeagueID int, returnView view) (tea.Model, tea.Cmd) {
+	m.fixturesState = ui.NewFixturesState(activeLeagueInfos(), focusLeagueID)
+	m.fixturesReturnView = returnView
+	m.currentView = viewFixtures
+	return m.loadFixtures()
+}
+
+// loadFixtures starts fetching the requested season of the currently picked league.
+func (m model) loadFixtures() (tea.Model, tea.Cmd) {
+	league := m.fixturesState.CurrentLeague()
+	if league.ID == 0 {
+		return m, nil
+	}
+
+	m.fixturesState.Loading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchLeagueSeason(m.client, league.ID, m.fixturesState.Requested))
+}
+
+// handleFixturesViewKeys processes keyboard input for the fixtures browser.
+// Left/right page through rounds, [/] switch seasons, tab cycles leagues.
+func (m model) handleFixturesViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.fixturesState == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "l", "right":
+		m.fixturesState.NextRound()
+	case "h", "left":
+		m.fixturesState.PrevRound()
+	case "[":
+		if m.fixturesState.OlderSeason() {
+			return m.loadFixtures()
+		}
+	case "]":
+		if m.fixturesState.NewerSeason() {
+			return m.loadFixtures()
+		}
+	case "tab":
+		m.fixturesState.NextLeague()
+		return m.loadFixtures()
+	case "shift+tab":
+		m.fixturesState.PrevLeague()
+		return m.loadFixtures()
+	case "j", "down":
+		m.fixturesState.ScrollDown(1)
+	case "k", "up":
+		m.fixturesState.ScrollUp(1)
+	}
+	return m, nil
+}
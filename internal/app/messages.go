@@ -2,7 +2,9 @@ package app
 
 import (
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/watch"
 )
 
 // liveUpdateMsg contains a live update string for match events.
@@ -23,6 +25,15 @@ type liveMatchesMsg struct {
 // liveRefreshMsg is sent when live matches are refreshed (periodic 5-min timer).
 type liveRefreshMsg struct {
 	matches []api.Match
+	partial *api.PartialResultError // set when some leagues failed to refresh
+	err     error                   // set when the refresh failed entirely
+	reload  bool                    // set for an immediate reload, which doesn't schedule the next refresh
+}
+
+// watchUpdateMsg is sent when the background watcher has polled every live
+// match (see watch.Watcher).
+type watchUpdateMsg struct {
+	update watch.Update
 }
 
 // liveBatchDataMsg contains live matches for a batch of leagues (parallel loading).
@@ -42,11 +53,42 @@ type statsDataMsg struct {
 // statsDayDataMsg contains stats data for a single day (progressive loading).
 // Sent as each day's API calls complete, allowing immediate UI updates.
 type statsDayDataMsg struct {
-	dayIndex int         // 0 = today, 1 = yesterday, etc.
-	isToday  bool        // true if this is today's data
-	isLast   bool        // true if this is the last day to fetch
-	finished []api.Match // finished matches for this day
-	upcoming []api.Match // upcoming matches (only for today)
+	dayIndex int                     // 0 = today, 1 = yesterday, etc.
+	isToday  bool                    // true if this is today's data
+	isLast   bool                    // true if this is the last day to fetch
+	finished []api.Match             // finished matches for this day
+	upcoming []api.Match             // upcoming matches (only for today)
+	partial  *api.PartialResultError // set when some leagues failed (matches above are still valid)
+	err      error                   // set when the whole day failed
+}
+
+// standingsMsg contains the league table for the standings view.
+type standingsMsg struct {
+	leagueID int
+	entries  []api.LeagueTableEntry
+	err      error
+}
+
+// leagueSeasonMsg contains a league season for the fixtures browser.
+type leagueSeasonMsg struct {
+	leagueID int
+	season   string // Requested season ("" = current)
+	result   *api.LeagueSeason
+	err      error
+}
+
+// leagueCatalogMsg contains the FotMob league catalog for the settings view.
+type leagueCatalogMsg struct {
+	leagues []data.LeagueInfo
+	err     error
+}
+
+// teamSearchMsg contains the teams found for a search in the favourites
+// section of the settings view.
+type teamSearchMsg struct {
+	query   string
+	results []api.TeamSearchResult
+	err     error
 }
 
 // pollTickMsg is sent when the 90-second poll interval elapses.
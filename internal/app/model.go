@@ -2,10 +2,15 @@
 package app
 
 import (
+	"time"
+
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/watch"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,6 +23,8 @@ const (
 	viewMain view = iota
 	viewLiveMatches
 	viewStats
+	viewStandings
+	viewFixtures
 	viewSettings
 )
 
@@ -39,9 +46,8 @@ type model struct {
 	matchDetails        *api.MatchDetails
 	matchDetailsCache map[int]*api.MatchDetails // Cache to avoid repeated API calls
 	liveUpdates       []string
-	lastEvents        []api.MatchEvent
-	lastHomeScore     int // Track last known home score for goal notifications
-	lastAwayScore     int // Track last known away score for goal notifications
+	lastDetails       *api.MatchDetails // Previous snapshot of the polled match, diffed against the next
+	timeline          []api.MatchEvent  // Status and VAR entries for the live updates, newest first
 
 	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
 	statsData *fotmob.StatsData
@@ -50,6 +56,10 @@ type model struct {
 	statsDaysLoaded int // Number of days loaded so far (0-5)
 	statsTotalDays  int // Total days to load (5)
 
+	// Stats load failures (reset on each fetch) - shown instead of a bare "No finished matches"
+	statsFailedLeagues map[int]bool // Leagues that failed for at least one day
+	statsFailedDays    int          // Days where every request failed
+
 	// Progressive loading state (live view) - batch-based for parallel fetching
 	liveBatchesLoaded int         // Number of batches loaded so far
 	liveTotalBatches  int         // Total batches to load
@@ -75,23 +85,62 @@ type model struct {
 	pendingSelection int // Tracks which view is being preloaded (-1 = none, 0 = stats, 1 = live)
 
 	// Configuration
-	useMockData    bool
-	statsDateRange int // 1, 3, or 5 days (default: 1)
+	statsDateRange      int           // 1, 3, or 5 days (default: 1)
+	pollInterval        time.Duration // Between polls of a live match
+	liveRefreshInterval time.Duration // Between live list refreshes
 
 	// Settings view state
 	settingsState *ui.SettingsState
 
-	// API clients
-	fotmobClient *fotmob.Client
-	parser       *fotmob.LiveUpdateParser
+	// Standings view state
+	standingsState      *ui.StandingsState
+	standingsReturnView view // View to return to on Esc (main menu or the match view it was opened from)
+
+	// Fixtures browser state
+	fixturesState      *ui.FixturesState
+	fixturesReturnView view // View to return to on Esc (main menu or standings)
+
+	// Data provider (FotMob, football-data.org or mock, see internal/provider)
+	client api.Provider
+	parser *fotmob.LiveUpdateParser
 
-	// Notifications
-	notifier *notify.DesktopNotifier
+	// Background polling of every live match, for notifications and live scores
+	watcher *watch.Watcher
+
+	// Notifications, and the rules from settings deciding which events are sent
+	notifier          notify.Notifier    // nil when attached to a daemon
+	dispatcher        *notify.Dispatcher // Backends from settings, closed on quit (nil if invalid)
+	notificationRules []data.NotificationRule
+
+	// Favourite teams from settings, pinned to the top of the match lists
+	// (the only ones listed in favourites-only mode)
+	favouriteTeams map[int]bool
+	favouritesOnly bool
 }
 
 // New creates a new application model with default values.
-// useMockData determines whether to use mock data instead of real API data.
-func New(useMockData bool) model {
+// client is the data provider selected in settings (or the mock provider
+// with --mock); tests can pass their own.
+func New(client api.Provider) model {
+	// Dates and kickoff times follow the timezone and clock format from settings
+	settings, _ := data.LoadSettings()
+	data.ApplyTimeSettings(settings)
+
+	// Notification backends from settings; desktop notifications if they're invalid
+	// ("golazo notify test" reports the error). Events notified before a
+	// restart aren't notified again. Attached to a daemon, the daemon sends
+	// them instead.
+	var notifier notify.Notifier
+	var dispatcher *notify.Dispatcher
+	if _, attached := client.(api.Remote); !attached {
+		var backends notify.Notifier = notify.NewDesktopNotifier()
+		if d, err := notify.FromSettings(settings.Notifications, nil); err == nil {
+			dispatcher, backends = d, d
+		}
+		notified, _ := data.LoadNotifiedLog()
+		notifier = notify.NewThrottle(backends, settings.Notifications, notified)
+	}
+
 	s := spinner.New()
 	s.Spinner = spinner.Line
 	s.Style = ui.SpinnerStyle()
@@ -121,6 +170,7 @@ func New(useMockData bool) model {
 	liveList.Styles.FilterCursor = filterCursorStyle
 	liveList.FilterInput.PromptStyle = filterPromptStyle
 	liveList.FilterInput.Cursor.Style = filterCursorStyle
+	liveList.AdditionalShortHelpKeys = matchListHelpKeys(settings.FavouritesOnly)
 
 	statsList := list.New([]list.Item{}, delegate, 0, 0)
 	statsList.SetShowTitle(false)
@@ -131,6 +181,7 @@ func New(useMockData bool) model {
 	statsList.Styles.FilterCursor = filterCursorStyle
 	statsList.FilterInput.PromptStyle = filterPromptStyle
 	statsList.FilterInput.Cursor.Style = filterCursorStyle
+	statsList.AdditionalShortHelpKeys = matchListHelpKeys(settings.FavouritesOnly)
 
 	upcomingList := list.New([]list.Item{}, delegate, 0, 0)
 	upcomingList.SetShowTitle(false)
@@ -142,13 +193,24 @@ func New(useMockData bool) model {
 	upcomingList.FilterInput.PromptStyle = filterPromptStyle
 	upcomingList.FilterInput.Cursor.Style = filterCursorStyle
 
+	// Simulated matches run faster than real ones; poll them more often
+	pollInterval, liveRefreshInterval := PollInterval, LiveRefreshInterval
+	if hint, ok := client.(api.PollIntervalHint); ok {
+		pollInterval = hint.PollInterval()
+		liveRefreshInterval = LiveRefreshInterval * pollInterval / PollInterval
+	}
+
 	return model{
 		currentView:         viewMain,
 		matchDetailsCache:   make(map[int]*api.MatchDetails),
-		useMockData:         useMockData,
-		fotmobClient:        fotmob.NewClient(),
+		client:              client,
 		parser:              fotmob.NewLiveUpdateParser(),
-		notifier:            notify.NewDesktopNotifier(),
+		watcher:             watch.New(client, pollInterval),
+		notifier:            notifier,
+		dispatcher:          dispatcher,
+		notificationRules:   settings.NotificationRules(),
+		favouriteTeams:      favouriteTeamIDs(settings),
+		favouritesOnly:      settings.FavouritesOnly,
 		spinner:             s,
 		randomSpinner:       randomSpinner,
 		statsViewSpinner:    statsViewSpinner,
@@ -157,11 +219,29 @@ func New(useMockData bool) model {
 		statsMatchesList:    statsList,
 		upcomingMatchesList: upcomingList,
 		statsDateRange:      1,
+		pollInterval:        pollInterval,
+		liveRefreshInterval: liveRefreshInterval,
 		pendingSelection:    -1, // No pending selection
 	}
 }
 
-// Init initializes the application.
+// matchListHelpKeys returns the extra key bindings shown in the match lists'
+// help bar, naming what "*" switches to.
+func matchListHelpKeys(favouritesOnly bool) func() []key.Binding {
+	only := "★ only"
+	if favouritesOnly {
+		only = "all matches"
+	}
+	return func() []key.Binding {
+		return []key.Binding{
+			key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "table")),
+			key.NewBinding(key.WithKeys("f", "F"), key.WithHelp("f/F", "★ home/away")),
+			key.NewBinding(key.WithKeys("*"), key.WithHelp("*", only)),
+		}
+	}
+}
+
+// Init initializes the application and starts watching live matches.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, ui.SpinnerTick())
+	return tea.Batch(m.spinner.Tick, ui.SpinnerTick(), pollWatcher(m.watcher))
 }
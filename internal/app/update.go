@@ -1,12 +1,13 @@
 package app
 
 import (
-	"strings"
-	"time"
-
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/watch"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -38,6 +39,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case liveRefreshMsg:
 		return m.handleLiveRefresh(msg)
 
+	case watchUpdateMsg:
+		return m.handleWatchUpdate(msg)
+
 	case liveBatchDataMsg:
 		return m.handleLiveBatchData(msg)
 
@@ -47,6 +51,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case statsDayDataMsg:
 		return m.handleStatsDayData(msg)
 
+	case standingsMsg:
+		return m.handleStandings(msg)
+
+	case leagueSeasonMsg:
+		return m.handleLeagueSeason(msg)
+
+	case leagueCatalogMsg:
+		return m.handleLeagueCatalog(msg)
+
+	case teamSearchMsg:
+		return m.handleTeamSearch(msg)
+
 	case ui.TickMsg:
 		return m.handleRandomSpinnerTick(msg)
 
@@ -107,7 +123,7 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 		// Settings list size is handled in RenderSettingsView
 		// but we update it here too for consistency
 		if m.settingsState != nil {
-			listHeight := m.height - 11 // Account for title, info, help
+			listHeight := m.height - 12 // Account for title, info, help
 			if listHeight < 5 {
 				listHeight = 5
 			}
@@ -136,7 +152,7 @@ func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
 
 	// Continue polling if match is live
 	if m.polling && m.matchDetails != nil && m.matchDetails.Status == api.MatchStatusLive {
-		return m, schedulePollTick(m.matchDetails.ID)
+		return m, schedulePollTick(m.matchDetails.ID, m.pollInterval)
 	}
 
 	m.loading = false
@@ -171,31 +187,16 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	if m.currentView == viewLiveMatches || m.pendingSelection == 1 {
 		m.liveViewLoading = false
 
-		// Get current scores
-		homeScore := 0
-		awayScore := 0
-		if msg.details.HomeScore != nil {
-			homeScore = *msg.details.HomeScore
-		}
-		if msg.details.AwayScore != nil {
-			awayScore = *msg.details.AwayScore
-		}
-
-		// Detect new goals during poll refresh (not initial load)
-		// Only notify when: polling is active AND we have previous score data
-		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.lastEvents) > 0
-		if m.polling && hasScoreData {
-			m.notifyNewGoals(msg.details)
-		}
-
-		// Update tracked scores for next comparison
-		m.lastHomeScore = homeScore
-		m.lastAwayScore = awayScore
+		// Diff against the previous snapshot for goals, VAR decisions, cards and
+		// status changes (nothing to compare against on the initial load)
+		changes := matchdiff.Diff(m.lastDetails, msg.details)
+		m.lastDetails = msg.details
+		m.applyMatchChanges(msg.details, changes)
 
 		// Parse ALL events to rebuild the live updates list
 		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
-		m.lastEvents = msg.details.Events
+		events := append(append([]api.MatchEvent{}, m.timeline...), msg.details.Events...)
+		m.liveUpdates = m.parser.ParseEvents(events, msg.details.HomeTeam, msg.details.AwayTeam)
 
 		// Continue polling if match is live
 		if msg.details.Status == api.MatchStatusLive {
@@ -207,8 +208,8 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 			// Note: if m.polling is true, m.loading stays true until the 1s timer fires
 
 			m.polling = true
-			// Schedule next poll tick (90 seconds from now)
-			cmds = append(cmds, schedulePollTick(msg.details.ID))
+			// Schedule next poll tick
+			cmds = append(cmds, schedulePollTick(msg.details.ID, m.pollInterval))
 		} else {
 			m.loading = false
 			m.polling = false
@@ -225,8 +226,14 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 
 // handleKeyPress routes key events to view-specific handlers.
 func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Typing a team search: keys (q and Esc included) go to the search box
+	if m.currentView == viewSettings && m.settingsState != nil && m.settingsState.Searching && msg.String() != "ctrl+c" {
+		return m.handleSettingsViewKeys(msg)
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c":
+		m.closeNotifications()
 		return m, tea.Quit
 	case "esc":
 		// Check if any list is in filtering mode - if so, let the list handle Esc
@@ -240,10 +247,14 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			isFiltering = m.statsMatchesList.FilterState() == list.Filtering ||
 				m.statsMatchesList.FilterState() == list.FilterApplied
 		case viewSettings:
-			if m.settingsState != nil {
+			if m.settingsState != nil && m.settingsState.Section == ui.SettingsLeagues {
 				isFiltering = m.settingsState.List.FilterState() == list.Filtering ||
 					m.settingsState.List.FilterState() == list.FilterApplied
 			}
+			// Team search results go back to the favourites
+			if m.settingsState != nil && m.settingsState.Section == ui.SettingsFavourites {
+				isFiltering = m.settingsState.ShowingSearch()
+			}
 		}
 
 		if isFiltering {
@@ -251,6 +262,20 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			break
 		}
 
+		// Fixtures opened from standings return to standings
+		if m.currentView == viewFixtures && m.fixturesReturnView != viewMain {
+			m.currentView = m.fixturesReturnView
+			m.fixturesState = nil
+			return m, nil
+		}
+
+		// Standings opened from a match view returns to that view
+		if m.currentView == viewStandings && m.standingsReturnView != viewMain {
+			m.currentView = m.standingsReturnView
+			m.standingsState = nil
+			return m, nil
+		}
+
 		if m.currentView != viewMain {
 			return m.resetToMainView()
 		}
@@ -264,6 +289,10 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleLiveMatchesSelection(msg)
 	case viewStats:
 		return m.handleStatsSelection(msg)
+	case viewStandings:
+		return m.handleStandingsViewKeys(msg)
+	case viewFixtures:
+		return m.handleFixturesViewKeys(msg)
 	case viewSettings:
 		return m.handleSettingsViewKeys(msg)
 	}
@@ -278,18 +307,32 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetails = nil
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
-	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.lastDetails = nil
+	m.timeline = nil
 	m.loading = false
 	m.polling = false
 	m.matches = nil
 	m.upcomingMatches = nil
+	m.standingsState = nil
+	m.fixturesState = nil
 	return m, nil
 }
 
 // handleLiveMatchesSelection handles list navigation in live matches view.
 func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Not while typing a filter: t opens standings for the selected match's
+	// league, f/F (un)favourite its home/away team, * toggles favourites only
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "t":
+			return m.openStandingsForSelectedMatch(viewLiveMatches)
+		case "f", "F":
+			return m.toggleFavourite(m.liveMatchesList.SelectedItem(), msg.String() == "f")
+		case "*":
+			return m.toggleFavouritesOnly()
+		}
+	}
+
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
 	var preUpdateMatchID int
 	if preItem := m.liveMatchesList.SelectedItem(); preItem != nil {
@@ -346,6 +389,14 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if msg.String() == "h" || msg.String() == "left" || msg.String() == "l" || msg.String() == "right" {
 			return m.handleStatsViewKeys(msg)
 		}
+		switch msg.String() {
+		case "t":
+			return m.openStandingsForSelectedMatch(viewStats)
+		case "f", "F":
+			return m.toggleFavourite(m.statsMatchesList.SelectedItem(), msg.String() == "f")
+		case "*":
+			return m.toggleFavouritesOnly()
+		}
 	}
 
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
@@ -400,7 +451,7 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh (5-min timer)
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.client, m.liveRefreshInterval))
 
 	if len(msg.matches) == 0 {
 		m.liveViewLoading = false
@@ -408,11 +459,8 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, favourites first
+	displayMatches := m.displayMatches(msg.matches)
 
 	m.matches = displayMatches
 	m.selected = 0
@@ -447,8 +495,20 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 
 	var cmds []tea.Cmd
 
-	// Schedule the next refresh
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	// Schedule the next refresh (a reload runs between scheduled refreshes)
+	if !msg.reload {
+		cmds = append(cmds, scheduleLiveRefresh(m.client, m.liveRefreshInterval))
+	}
+
+	// A failed refresh keeps the current list rather than clearing it
+	if msg.err != nil {
+		return m, tea.Batch(cmds...)
+	}
+
+	// Leagues that failed to refresh keep their previously shown matches
+	if msg.partial != nil {
+		msg.matches = keepMatchesForLeagues(msg.matches, m.matches, msg.partial.FailedLeagues())
+	}
 
 	if len(msg.matches) == 0 {
 		// No live matches - clear list but keep view
@@ -457,11 +517,8 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, favourites first
+	displayMatches := m.displayMatches(msg.matches)
 
 	// Preserve current selection if possible
 	currentMatchID := 0
@@ -487,6 +544,64 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// handleWatchUpdate processes a background poll of every live match: the
+// changes the notification rules turn on are notified whichever match is open,
+// and the live list scores are brought up to date. The watcher always
+// schedules its next poll, in any view.
+func (m model) handleWatchUpdate(msg watchUpdateMsg) (tea.Model, tea.Cmd) {
+	if m.notifier != nil {
+		for _, match := range msg.update.Matches {
+			for _, n := range notify.Notifications(m.notificationRules, match.Details, match.Changes) {
+				// Errors are silently ignored to not disrupt the app
+				_ = m.notifier.Notify(n)
+			}
+		}
+	}
+
+	if m.currentView == viewLiveMatches {
+		m.updateLiveScores(msg.update.Matches)
+	}
+
+	return m, scheduleWatch(m.watcher, msg.update.Next)
+}
+
+// closeNotifications sends the digest the rate limit is holding back and
+// waits for the notifications still being sent, as the daemon does on
+// shutdown.
+func (m model) closeNotifications() {
+	if throttle, ok := m.notifier.(*notify.Throttle); ok {
+		_ = throttle.Flush()
+	}
+	if m.dispatcher != nil {
+		m.dispatcher.Close()
+	}
+}
+
+// updateLiveScores copies the scores, clocks and statuses of polled matches
+// into the live list, keeping the selection.
+func (m *model) updateLiveScores(updates []watch.MatchUpdate) {
+	latest := make(map[int]*api.MatchDetails, len(updates))
+	for _, update := range updates {
+		latest[update.Details.ID] = update.Details
+	}
+
+	updated := false
+	for i := range m.matches {
+		details, ok := latest[m.matches[i].ID]
+		if !ok {
+			continue
+		}
+		m.matches[i].Status = details.Status
+		m.matches[i].HomeScore = details.HomeScore
+		m.matches[i].AwayScore = details.AwayScore
+		m.matches[i].LiveTime = details.LiveTime
+		updated = true
+	}
+	if updated {
+		m.liveMatchesList.SetItems(ui.ToMatchListItems(m.matches))
+	}
+}
+
 // handleLiveBatchData processes parallel batch loading - multiple leagues at once.
 // Results are shown after each batch completes, giving progressive updates while being fast.
 func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
@@ -502,10 +617,7 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 
 	// Update UI immediately with current data
 	if len(m.liveMatchesBuffer) > 0 {
-		displayMatches := make([]ui.MatchDisplay, 0, len(m.liveMatchesBuffer))
-		for _, match := range m.liveMatchesBuffer {
-			displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-		}
+		displayMatches := m.displayMatches(m.liveMatchesBuffer)
 		m.matches = displayMatches
 		m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
 		m.updateLiveListSize()
@@ -529,19 +641,19 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		m.loading = false
 
 		// Cache the final result
-		if m.fotmobClient != nil && len(m.liveMatchesBuffer) > 0 {
-			m.fotmobClient.Cache().SetLiveMatches(m.liveMatchesBuffer)
+		if store, ok := m.client.(api.LiveMatchesStore); ok && len(m.liveMatchesBuffer) > 0 {
+			store.StoreLiveMatches(m.liveMatchesBuffer)
 		}
 
 		// Schedule periodic refresh
-		cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+		cmds = append(cmds, scheduleLiveRefresh(m.client, m.liveRefreshInterval))
 
 		return m, tea.Batch(cmds...)
 	}
 
 	// Otherwise, fetch next batch
 	nextBatchIndex := msg.batchIndex + 1
-	cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, nextBatchIndex))
+	cmds = append(cmds, fetchLiveBatchData(m.client, nextBatchIndex))
 
 	// Keep spinner running
 	cmds = append(cmds, ui.SpinnerTick())
@@ -649,11 +761,20 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 		}
 
 		// Populate liveUpcomingMatches for the live view
-		upcomingDisplay := make([]ui.MatchDisplay, 0, len(m.statsData.TodayUpcoming))
-		for _, match := range m.statsData.TodayUpcoming {
-			upcomingDisplay = append(upcomingDisplay, ui.MatchDisplay{Match: match})
+		m.liveUpcomingMatches = m.displayMatches(m.statsData.TodayUpcoming)
+	}
+
+	// Record failures so the view can say data is incomplete
+	if msg.partial != nil {
+		if m.statsFailedLeagues == nil {
+			m.statsFailedLeagues = make(map[int]bool)
+		}
+		for _, id := range msg.partial.FailedLeagues() {
+			m.statsFailedLeagues[id] = true
 		}
-		m.liveUpcomingMatches = upcomingDisplay
+	}
+	if msg.err != nil {
+		m.statsFailedDays++
 	}
 
 	// Track progress
@@ -683,7 +804,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 
 	// Otherwise, fetch next day
 	nextDayIndex := msg.dayIndex + 1
-	cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, nextDayIndex, m.statsTotalDays))
+	cmds = append(cmds, fetchStatsDayData(m.client, nextDayIndex, m.statsTotalDays))
 
 	// Keep spinner running
 	cmds = append(cmds, ui.SpinnerTick())
@@ -691,6 +812,69 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// handleStandings processes league table responses for the standings view.
+// Responses for a league other than the one currently picked are ignored
+// (the user may have moved on while the request was in flight).
+func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
+	if m.standingsState == nil || m.standingsState.CurrentLeague().ID != msg.leagueID {
+		return m, nil
+	}
+
+	m.standingsState.Loading = false
+	m.standingsState.Entries = msg.entries
+	m.standingsState.Err = msg.err
+	return m, nil
+}
+
+// keepMatchesForLeagues appends previously displayed matches from the given
+// leagues to fresh, skipping any match fresh already contains.
+func keepMatchesForLeagues(fresh []api.Match, previous []ui.MatchDisplay, leagueIDs []int) []api.Match {
+	keep := make(map[int]bool, len(leagueIDs))
+	for _, id := range leagueIDs {
+		keep[id] = true
+	}
+	present := make(map[int]bool, len(fresh))
+	for _, match := range fresh {
+		present[match.ID] = true
+	}
+
+	for _, display := range previous {
+		if keep[display.League.ID] && !present[display.ID] {
+			fresh = append(fresh, display.Match)
+		}
+	}
+	return fresh
+}
+
+// handleLeagueSeason processes league season responses for the fixtures browser.
+// Responses for another league or season than the one requested are ignored.
+func (m model) handleLeagueSeason(msg leagueSeasonMsg) (tea.Model, tea.Cmd) {
+	if m.fixturesState == nil || m.fixturesState.CurrentLeague().ID != msg.leagueID || m.fixturesState.Requested != msg.season {
+		return m, nil
+	}
+
+	m.fixturesState.Loading = false
+	m.fixturesState.Err = msg.err
+	m.fixturesState.SetSeason(msg.result)
+	return m, nil
+}
+
+// handleLeagueCatalog refreshes the settings list once the league catalog arrives.
+// On error the list keeps showing the cached or built-in leagues.
+func (m model) handleLeagueCatalog(msg leagueCatalogMsg) (tea.Model, tea.Cmd) {
+	if m.settingsState == nil || msg.err != nil || len(msg.leagues) == 0 {
+		return m, nil
+	}
+
+	// Don't reshuffle items under an active filter
+	if m.settingsState.List.FilterState() != list.Unfiltered {
+		return m, nil
+	}
+
+	m.settingsState.SetLeagues(msg.leagues)
+	return m, nil
+}
+
 // applyStatsDateFilter applies the current date range filter to the cached stats data.
 // This enables instant switching between Today/3d/5d views without new API calls.
 // All filtering is done client-side from the cached 5-day data based on match MatchTime.
@@ -713,33 +897,28 @@ func (m *model) applyStatsDateFilter() {
 		finishedMatches = m.statsData.AllFinished
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(finishedMatches))
-	for _, match := range finishedMatches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, favourites first
+	displayMatches := m.displayMatches(finishedMatches)
 	m.matches = displayMatches
 	m.statsMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
 	// Note: Upcoming matches are now shown in the Live view instead
 }
 
 // filterMatchesByDays filters matches to only include those from the last N days.
-// Uses LOCAL time for date comparison so "today" matches user's actual timezone.
+// Uses the configured timezone so "today" matches the user's actual day.
 func filterMatchesByDays(matches []api.Match, days int) []api.Match {
 	if days <= 0 {
 		return matches
 	}
 
-	// Use local time so "today" matches the user's actual day
-	now := time.Now().Local()
+	now := data.Now()
 	cutoff := now.AddDate(0, 0, -(days - 1)) // Include today as day 1
-	cutoffDate := cutoff.Format("2006-01-02")
+	cutoffDate := data.DateKey(cutoff)
 
 	var filtered []api.Match
 	for _, match := range matches {
 		if match.MatchTime != nil {
-			// Compare in local time
-			matchDate := match.MatchTime.Local().Format("2006-01-02")
+			matchDate := data.DateKey(*match.MatchTime)
 			if matchDate >= cutoffDate {
 				filtered = append(filtered, match)
 			}
@@ -752,7 +931,9 @@ func filterMatchesByDays(matches []api.Match, days int) []api.Match {
 // Uses a SINGLE tick chain - all spinners share the same tick rate.
 func (m model) handleRandomSpinnerTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 	// Check if any spinner needs to be animated
-	needsTick := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling
+	standingsLoading := m.standingsState != nil && m.standingsState.Loading
+	fixturesLoading := m.fixturesState != nil && m.fixturesState.Loading
+	needsTick := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling || standingsLoading || fixturesLoading
 
 	if !needsTick {
 		// No spinners active - don't continue the tick chain
@@ -768,6 +949,14 @@ func (m model) handleRandomSpinnerTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 		m.randomSpinner.Tick()
 	}
 
+	if standingsLoading && m.currentView == viewStandings {
+		m.randomSpinner.Tick()
+	}
+
+	if fixturesLoading && m.currentView == viewFixtures {
+		m.randomSpinner.Tick()
+	}
+
 	if m.statsViewLoading {
 		m.statsViewSpinner.Tick()
 	}
@@ -841,6 +1030,14 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 // handlePollTick handles the 90-second poll tick.
 // Shows "Updating..." spinner for 1s as visual feedback, then fetches data.
 func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
+	// Keep the poll chain alive while standings (or fixtures opened from them)
+	// are shown on top of the live view
+	overLive := m.standingsReturnView == viewLiveMatches &&
+		(m.currentView == viewStandings || (m.currentView == viewFixtures && m.fixturesReturnView == viewStandings))
+	if overLive && m.polling {
+		return m, schedulePollTick(msg.matchID, m.pollInterval)
+	}
+
 	// Only process if we're still in live view and polling is active
 	if m.currentView != viewLiveMatches || !m.polling {
 		return m, nil
@@ -856,7 +1053,7 @@ func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 
 	// Start the actual API call, spinner animation, and 1s display timer
 	return m, tea.Batch(
-		fetchPollMatchDetails(m.fotmobClient, msg.matchID, m.useMockData),
+		fetchPollMatchDetails(m.client, msg.matchID),
 		ui.SpinnerTick(),
 		schedulePollSpinnerHide(), // Hide spinner after 0.5 seconds
 	)
@@ -894,60 +1091,3 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 
 	return m, cmd
 }
-
-// notifyNewGoals sends desktop notifications when a goal is scored.
-// Uses score-based detection (more reliable than event ID comparison).
-// Only called during poll refreshes when we have previous score data.
-func (m *model) notifyNewGoals(details *api.MatchDetails) {
-	if m.notifier == nil || details == nil {
-		return
-	}
-
-	// Get current scores
-	homeScore := 0
-	awayScore := 0
-	if details.HomeScore != nil {
-		homeScore = *details.HomeScore
-	}
-	if details.AwayScore != nil {
-		awayScore = *details.AwayScore
-	}
-
-	// Check if score increased (goal scored)
-	homeGoalScored := homeScore > m.lastHomeScore
-	awayGoalScored := awayScore > m.lastAwayScore
-
-	if !homeGoalScored && !awayGoalScored {
-		return
-	}
-
-	// Find the most recent goal event to get player details
-	var goalEvent *api.MatchEvent
-	for i := len(details.Events) - 1; i >= 0; i-- {
-		event := details.Events[i]
-		if strings.ToLower(event.Type) == "goal" {
-			// Check if this goal matches the team that scored
-			if homeGoalScored && event.Team.ID == details.HomeTeam.ID {
-				goalEvent = &event
-				break
-			}
-			if awayGoalScored && event.Team.ID == details.AwayTeam.ID {
-				goalEvent = &event
-				break
-			}
-		}
-	}
-
-	if goalEvent != nil {
-		// Send notification - errors are silently ignored to not disrupt the app
-		_ = m.notifier.Goal(*goalEvent, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
-	}
-}
-
-// max returns the larger of two integers.
-func max(a, b int) int {
-	if a > b {
-		return a
-	}
-	return b
-}
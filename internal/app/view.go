@@ -1,6 +1,10 @@
 package app
 
-import "github.com/0xjuanma/golazo/internal/ui"
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/ui"
+)
 
 // View renders the current application state.
 func (m model) View() string {
@@ -38,8 +42,14 @@ func (m model) View() string {
 			m.statsDateRange,
 			m.statsDaysLoaded,
 			m.statsTotalDays,
+			m.statsLoadWarning(),
 		)
 
+	case viewStandings:
+		return ui.RenderStandingsView(m.width, m.height, m.standingsState, m.randomSpinner)
+
+	case viewFixtures:
+		return ui.RenderFixturesView(m.width, m.height, m.fixturesState, m.randomSpinner)
 	case viewSettings:
 		return ui.RenderSettingsView(m.width, m.height, m.settingsState)
 
@@ -101,3 +111,16 @@ func (m *model) ensureStatsSpinner() *ui.RandomCharSpinner {
 	}
 	return m.statsViewSpinner
 }
+
+// statsLoadWarning summarises load failures for the stats view ("" when complete).
+func (m model) statsLoadWarning() string {
+	switch {
+	case m.statsFailedDays > 0:
+		return fmt.Sprintf("⚠ %d of %d days failed to load", m.statsFailedDays, m.statsTotalDays)
+	case len(m.statsFailedLeagues) == 1:
+		return "⚠ 1 league failed to load"
+	case len(m.statsFailedLeagues) > 1:
+		return fmt.Sprintf("⚠ %d leagues failed to load", len(m.statsFailedLeagues))
+	}
+	return ""
+}
@@ -0,0 +1,193 @@
+// Package cache holds providers' in-memory response caches: an LRU per
+// response type with TTLs chosen by match state, serving stale entries while
+// they are refreshed.
+package cache
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// TTLPolicy sets how long cached data stays fresh depending on match state.
+type TTLPolicy struct {
+	Live     time.Duration // Data involving a match in progress
+	Upcoming time.Duration // Data with matches still to be played
+	Finished time.Duration // Data where every match is over (won't change)
+}
+
+// ForStatus returns the TTL for data about a single match.
+func (p TTLPolicy) ForStatus(status api.MatchStatus) time.Duration {
+	switch status {
+	case api.MatchStatusLive:
+		return p.Live
+	case api.MatchStatusFinished, api.MatchStatusCancelled:
+		return p.Finished
+	default:
+		return p.Upcoming
+	}
+}
+
+// ForMatches returns the TTL for a list of matches: the shortest TTL of any
+// match in it, so one live match keeps the whole list fresh. An empty list
+// gets the Upcoming TTL: fixtures may not be published yet.
+func (p TTLPolicy) ForMatches(matches []api.Match) time.Duration {
+	if len(matches) == 0 {
+		return p.Upcoming
+	}
+	ttl := p.Finished
+	for _, match := range matches {
+		if matchTTL := p.ForStatus(match.Status); matchTTL < ttl {
+			ttl = matchTTL
+		}
+	}
+	return ttl
+}
+
+// Config holds configuration for API response caching.
+type Config struct {
+	TTL             TTLPolicy     // Freshness by match state
+	StaleFor        time.Duration // How long expired entries are still served while a refresh runs
+	MaxMatchesCache int           // Maximum number of date entries to cache
+	MaxDetailsCache int           // Maximum number of match details to cache
+	MaxSeasonsCache int           // Maximum number of league seasons to cache
+}
+
+// DefaultConfig returns sensible defaults for caching.
+func DefaultConfig() Config {
+	return Config{
+		TTL: TTLPolicy{
+			Live:     2 * time.Minute,  // Scores change; polls refill it, but views and the server read it between polls
+			Upcoming: 15 * time.Minute, // Kickoff times and line-ups change occasionally
+			Finished: 24 * time.Hour,   // Results don't change
+		},
+		StaleFor:        10 * time.Minute, // Show the last data instantly, refresh behind it
+		MaxMatchesCache: 20,               // Cache up to 20 date queries
+		MaxDetailsCache: 100,              // Cache up to 100 match details
+		MaxSeasonsCache: 20,               // Cache up to 20 league seasons
+	}
+}
+
+// Responses provides thread-safe caching for API responses.
+// Each response type has its own LRU with TTLs chosen by the TTL policy.
+type Responses struct {
+	config  Config
+	matches *lruCache[string, []api.Match]       // key: "YYYY-MM-DD@zone:tabs"
+	details *lruCache[int, *api.MatchDetails]    // key: matchID
+	live    *lruCache[string, []api.Match]       // Single entry for live matches
+	seasons *lruCache[string, *api.LeagueSeason] // key: "leagueID:season"
+}
+
+// ResponseStats holds the counters of every cache, for debug displays.
+type ResponseStats struct {
+	Matches Stats
+	Details Stats
+	Live    Stats
+	Seasons Stats
+}
+
+// All returns the stats as a list, in display order.
+func (s ResponseStats) All() []Stats {
+	return []Stats{s.Live, s.Matches, s.Details, s.Seasons}
+}
+
+const liveCacheKey = "live"
+
+// New creates a new cache with the given configuration.
+func New(config Config) *Responses {
+	return &Responses{
+		config:  config,
+		matches: newLRUCache[string, []api.Match]("matches", config.MaxMatchesCache, config.StaleFor),
+		details: newLRUCache[int, *api.MatchDetails]("details", config.MaxDetailsCache, config.StaleFor),
+		live:    newLRUCache[string, []api.Match]("live", 1, config.StaleFor),
+		seasons: newLRUCache[string, *api.LeagueSeason]("seasons", config.MaxSeasonsCache, config.StaleFor),
+	}
+}
+
+// Stats returns hit/miss/eviction counters for every cache.
+func (c *Responses) Stats() ResponseStats {
+	return ResponseStats{
+		Matches: c.matches.stats(),
+		Details: c.details.stats(),
+		Live:    c.live.stats(),
+		Seasons: c.seasons.stats(),
+	}
+}
+
+// Matches retrieves cached matches for a date key and how fresh they are.
+func (c *Responses) Matches(dateKey string) ([]api.Match, Freshness) {
+	return c.matches.get(dateKey)
+}
+
+// SetMatches stores matches, fresh for as long as the TTL policy allows.
+func (c *Responses) SetMatches(dateKey string, matches []api.Match) {
+	c.matches.set(dateKey, matches, c.config.TTL.ForMatches(matches))
+}
+
+// Details retrieves cached match details and how fresh they are.
+func (c *Responses) Details(matchID int) (*api.MatchDetails, Freshness) {
+	return c.details.get(matchID)
+}
+
+// HasFreshDetails reports whether fresh details are cached, without counting a lookup.
+func (c *Responses) HasFreshDetails(matchID int) bool {
+	return c.details.peek(matchID)
+}
+
+// SetDetails stores match details, fresh for the TTL of the match's status.
+// Finished matches stay fresh much longer since the data won't change.
+func (c *Responses) SetDetails(matchID int, details *api.MatchDetails) {
+	ttl := c.config.TTL.Upcoming
+	if details != nil {
+		ttl = c.config.TTL.ForStatus(details.Status)
+	}
+	c.details.set(matchID, details, ttl)
+}
+
+// CachedMatchIDs returns all match IDs currently in the details cache,
+// most recently used first.
+func (c *Responses) CachedMatchIDs() []int {
+	return c.details.keys()
+}
+
+// ClearDetailsCache clears all cached match details.
+func (c *Responses) ClearDetailsCache() {
+	c.details.clear()
+}
+
+// ClearMatchDetails removes a specific match from the details cache.
+// Use this to force a refresh on next fetch for a specific match.
+func (c *Responses) ClearMatchDetails(matchID int) {
+	c.details.remove(matchID)
+}
+
+// LiveMatches retrieves cached live matches and how fresh they are.
+func (c *Responses) LiveMatches() ([]api.Match, Freshness) {
+	return c.live.get(liveCacheKey)
+}
+
+// SetLiveMatches stores live matches with the live TTL.
+func (c *Responses) SetLiveMatches(matches []api.Match) {
+	c.live.set(liveCacheKey, matches, c.config.TTL.Live)
+}
+
+// ClearLiveCache invalidates the live matches cache.
+// Call this to force a refresh on next fetch.
+func (c *Responses) ClearLiveCache() {
+	c.live.clear()
+}
+
+// LeagueSeason retrieves a cached league season and how fresh it is.
+func (c *Responses) LeagueSeason(key string) (*api.LeagueSeason, Freshness) {
+	return c.seasons.get(key)
+}
+
+// SetLeagueSeason stores a league season in cache.
+// Past seasons use the finished TTL since their results won't change.
+func (c *Responses) SetLeagueSeason(key string, season *api.LeagueSeason, past bool) {
+	ttl := c.config.TTL.Upcoming
+	if past {
+		ttl = c.config.TTL.Finished
+	}
+	c.seasons.set(key, season, ttl)
+}
@@ -0,0 +1,29 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cache"
+)
+
+func TestTTLPolicyForMatches(t *testing.T) {
+	policy := cache.DefaultConfig().TTL
+
+	tests := []struct {
+		name    string
+		matches []api.Match
+		want    time.Duration
+	}{
+		{"empty", nil, policy.Upcoming},
+		{"finished", []api.Match{{Status: api.MatchStatusFinished}}, policy.Finished},
+		{"upcoming", []api.Match{{Status: api.MatchStatusFinished}, {Status: api.MatchStatusNotStarted}}, policy.Upcoming},
+		{"live", []api.Match{{Status: api.MatchStatusNotStarted}, {Status: api.MatchStatusLive}}, policy.Live},
+	}
+	for _, tt := range tests {
+		if got := policy.ForMatches(tt.matches); got != tt.want {
+			t.Errorf("%s: ForMatches() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Freshness describes a cache lookup result.
+type Freshness int
+
+const (
+	Miss  Freshness = iota // Not cached, or too old to serve
+	Fresh                  // Within its TTL
+	Stale                  // Past its TTL but within the stale window: serve it and refresh
+)
+
+// Stats holds counters for one cache, for debug displays.
+type Stats struct {
+	Name      string
+	Entries   int
+	Capacity  int
+	Hits      int64 // Fresh entries served
+	StaleHits int64 // Stale entries served while refreshing
+	Misses    int64
+	Evictions int64 // Entries dropped to make room (expired entries don't count)
+}
+
+// HitRate returns the share of lookups served from the cache (fresh or stale).
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.StaleHits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits+s.StaleHits) / float64(total)
+}
+
+// String formats the stats for debug output.
+func (s Stats) String() string {
+	return fmt.Sprintf("%s: %d/%d entries, %d hits, %d stale, %d misses (%.0f%%), %d evictions",
+		s.Name, s.Entries, s.Capacity, s.Hits, s.StaleHits, s.Misses, s.HitRate()*100, s.Evictions)
+}
+
+// lruEntry is one cached value with its freshness deadlines.
+type lruEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	freshUntil time.Time
+	staleUntil time.Time
+}
+
+// lruCache is a thread-safe least-recently-used cache with per-entry TTLs.
+// Entries past their TTL are still returned as Stale for staleFor, so callers
+// can serve them while refreshing in the background.
+type lruCache[K comparable, V any] struct {
+	name     string
+	capacity int
+	staleFor time.Duration
+
+	mu      sync.Mutex
+	order   *list.List // Front = most recently used
+	entries map[K]*list.Element
+
+	hits, staleHits, misses, evictions int64
+}
+
+// newLRUCache creates an empty cache holding at most capacity entries.
+func newLRUCache[K comparable, V any](name string, capacity int, staleFor time.Duration) *lruCache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &lruCache[K, V]{
+		name:     name,
+		capacity: capacity,
+		staleFor: staleFor,
+		order:    list.New(),
+		entries:  make(map[K]*list.Element),
+	}
+}
+
+// get returns the value for key and how fresh it is.
+// Entries past the stale window are removed and reported as a Miss.
+func (c *lruCache[K, V]) get(key K) (V, Freshness) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return zero, Miss
+	}
+
+	entry := elem.Value.(*lruEntry[K, V])
+	now := time.Now()
+	switch {
+	case now.Before(entry.freshUntil):
+		c.hits++
+		c.order.MoveToFront(elem)
+		return entry.value, Fresh
+	case now.Before(entry.staleUntil):
+		c.staleHits++
+		c.order.MoveToFront(elem)
+		return entry.value, Stale
+	}
+
+	c.order.Remove(elem)
+	delete(c.entries, key)
+	c.misses++
+	return zero, Miss
+}
+
+// peek reports whether key holds a fresh value, without touching recency or counters.
+func (c *lruCache[K, V]) peek(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	return ok && time.Now().Before(elem.Value.(*lruEntry[K, V]).freshUntil)
+}
+
+// set stores value for ttl, evicting the least recently used entry if full.
+func (c *lruCache[K, V]) set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	entry := &lruEntry[K, V]{
+		key:        key,
+		value:      value,
+		freshUntil: now.Add(ttl),
+		staleUntil: now.Add(ttl + c.staleFor),
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	for c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+		if now.Before(oldest.Value.(*lruEntry[K, V]).staleUntil) {
+			c.evictions++
+		}
+	}
+	c.entries[key] = c.order.PushFront(entry)
+}
+
+// remove drops key from the cache.
+func (c *lruCache[K, V]) remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// clear drops every entry (counters are kept).
+func (c *lruCache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[K]*list.Element)
+}
+
+// keys returns the cached keys, most recently used first.
+func (c *lruCache[K, V]) keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]K, 0, c.order.Len())
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		keys = append(keys, elem.Value.(*lruEntry[K, V]).key)
+	}
+	return keys
+}
+
+// stats returns a snapshot of the counters.
+func (c *lruCache[K, V]) stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Stats{
+		Name:      c.name,
+		Entries:   c.order.Len(),
+		Capacity:  c.capacity,
+		Hits:      c.hits,
+		StaleHits: c.staleHits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+}
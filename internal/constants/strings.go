@@ -4,6 +4,8 @@ package constants
 const (
 	MenuStats       = "Finished Matches"
 	MenuLiveMatches = "Live Matches"
+	MenuStandings   = "Standings"
+	MenuFixtures    = "Fixtures & Results"
 	MenuSettings    = "Settings"
 )
 
@@ -14,6 +16,8 @@ const (
 	PanelMinuteByMinute  = "Minute-by-minute"
 	PanelMatchStatistics = "Match Statistics"
 	PanelUpdates         = "Updates"
+	PanelStandings       = "Standings"
+	PanelFixtures        = "Fixtures & Results"
 )
 
 // Empty state messages
@@ -23,13 +27,21 @@ const (
 	EmptySelectMatch       = "Select a match"
 	EmptyNoUpdates         = "No updates"
 	EmptyNoMatches         = "No matches available"
+	EmptyNoStandings       = "No table available for this competition"
+	EmptyNoFixtures        = "No fixtures available for this season"
+	EmptyLoadFailed        = "Could not load matches"
 )
 
 // Help text
 const (
-	HelpMainMenu     = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView  = "↑/↓: navigate  /: filter  Esc: back  q: quit"
-	HelpSettingsView = "↑/↓: navigate  Space: toggle  /: filter  Enter: save  Esc: back"
+	HelpMainMenu              = "↑/↓: navigate  Enter: select  q: quit"
+	HelpMatchesView           = "↑/↓: navigate  /: filter  Esc: back  q: quit"
+	HelpSettingsView          = "↑/↓: navigate  Tab: next country  Space: toggle  z: timezone  c: 12h/24h  f: favourites  n: notifications  /: filter  Enter: save  Esc: back"
+	HelpSettingsNotifications = "↑/↓: navigate  Tab: next rule  Space: toggle  a: add rule  d: delete rule  n: leagues  Enter: save  Esc: back"
+	HelpSettingsFavourites    = "↑/↓: navigate  /: search teams  Space: toggle  f: leagues  Enter: save  Esc: back"
+	HelpSettingsTeamSearch    = "Enter: search  Esc: cancel"
+	HelpStandingsView         = "←/→: league  ↑/↓: scroll  m: fixtures  Esc: back  q: quit"
+	HelpFixturesView          = "←/→: round  [/]: season  Tab: league  ↑/↓: scroll  Esc: back"
 )
 
 // Status text
@@ -50,6 +62,15 @@ const (
 const (
 	// NotificationTitleGoal is the title shown in goal notifications.
 	NotificationTitleGoal = "⚽ GOLAZO!"
+
+	// Titles of the other events notification rules can turn on.
+	NotificationTitleKickoff  = "Kick-off"
+	NotificationTitleHalfTime = "Half-time"
+	NotificationTitleFullTime = "Full-time"
+	NotificationTitleRedCard  = "🟥 Red card"
+	NotificationTitlePenalty  = "Penalty"
+	NotificationTitleVAR      = "VAR: goal disallowed"
+	NotificationTitleLineups  = "Line-ups"
 )
 
 // Stats labels
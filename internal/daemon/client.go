@@ -0,0 +1,240 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/server"
+)
+
+// errNotFound is returned by get when the daemon answers 404, which it does
+// for match details and seasons the provider returned nil for.
+var errNotFound = errors.New("not found")
+
+// Client reads a daemon's data. Force refreshes read the daemon's cache like
+// other calls: the daemon's own polling keeps live matches and their details
+// fresh, and it alone requests them upstream.
+type Client struct {
+	path         string
+	http         *http.Client
+	pollInterval time.Duration
+}
+
+var (
+	_ api.Provider         = (*Client)(nil)
+	_ api.PollIntervalHint = (*Client)(nil)
+	_ api.TeamSearcher     = (*Client)(nil)
+	_ api.Remote           = (*Client)(nil)
+)
+
+// Dial connects to the daemon listening on the socket at path, checking
+// that it answers.
+func Dial(ctx context.Context, path string) (*Client, error) {
+	c := &Client{
+		path: path,
+		http: &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", path)
+			},
+		}},
+	}
+
+	var health server.Health
+	if err := c.get(ctx, "/healthz", nil, &health); err != nil {
+		return nil, err
+	}
+	c.pollInterval, _ = time.ParseDuration(health.PollInterval)
+	return c, nil
+}
+
+// Address returns the daemon's socket path.
+func (c *Client) Address() string {
+	return c.path
+}
+
+// PollInterval returns the daemon's poll interval, so the TUI refreshes its
+// views at the pace the daemon's data changes.
+func (c *Client) PollInterval() time.Duration {
+	return c.pollInterval
+}
+
+// MatchesByDate retrieves the matches of a date from the daemon.
+func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.matches(ctx, "/matches", url.Values{"date": {dateParam(date)}})
+}
+
+// ResultsByDate retrieves the matches of a date that can have finished from the daemon.
+func (c *Client) ResultsByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.matches(ctx, "/results", url.Values{"date": {dateParam(date)}})
+}
+
+// MatchDetails retrieves a match's details from the daemon (nil if it has none).
+func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	var details api.MatchDetails
+	if err := c.get(ctx, fmt.Sprintf("/matches/%d", matchID), nil, &details); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &details, nil
+}
+
+// MatchDetailsForceRefresh is MatchDetails: the daemon's polling refreshes
+// the details of live matches.
+func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return c.MatchDetails(ctx, matchID)
+}
+
+// Leagues retrieves the available leagues from the daemon.
+func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
+	var leagues []api.League
+	if err := c.get(ctx, "/leagues", nil, &leagues); err != nil {
+		return nil, err
+	}
+	return leagues, nil
+}
+
+// LeagueMatches retrieves a league's current season matches from the daemon.
+func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
+	return c.matches(ctx, fmt.Sprintf("/leagues/%d/matches", leagueID), nil)
+}
+
+// LeagueSeason retrieves a league season from the daemon (nil if it has none).
+func (c *Client) LeagueSeason(ctx context.Context, leagueID int, season string) (*api.LeagueSeason, error) {
+	var query url.Values
+	if season != "" {
+		query = url.Values{"season": {season}}
+	}
+	var result api.LeagueSeason
+	if err := c.get(ctx, fmt.Sprintf("/leagues/%d/season", leagueID), query, &result); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LeagueTable retrieves a league table from the daemon.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	var table []api.LeagueTableEntry
+	if err := c.get(ctx, fmt.Sprintf("/leagues/%d/table", leagueID), nil, &table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// LiveMatches retrieves the live matches from the daemon.
+func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	return c.matches(ctx, "/live", nil)
+}
+
+// LiveMatchesForceRefresh is LiveMatches: the daemon's polling refreshes
+// the live matches.
+func (c *Client) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
+	return c.LiveMatches(ctx)
+}
+
+// LiveMatchesForLeague retrieves a league's live matches from the daemon.
+func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
+	return c.matches(ctx, fmt.Sprintf("/leagues/%d/live", leagueID), nil)
+}
+
+// SearchTeams looks teams up by name through the daemon.
+func (c *Client) SearchTeams(ctx context.Context, query string) ([]api.TeamSearchResult, error) {
+	var teams []api.TeamSearchResult
+	if err := c.get(ctx, "/teams", url.Values{"q": {query}}, &teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// matches retrieves a match list. The matches that loaded are returned with
+// the partial result error when some leagues failed.
+func (c *Client) matches(ctx context.Context, path string, query url.Values) ([]api.Match, error) {
+	var matches []api.Match
+	err := c.get(ctx, path, query, &matches)
+	if _, ok := api.AsPartialResult(err); err != nil && !ok {
+		return nil, err
+	}
+	return matches, err
+}
+
+// get decodes the JSON response to a GET request into v. Leagues listed in
+// server.FailedLeaguesHeader are returned as an *api.PartialResultError,
+// after decoding.
+func (c *Client) get(ctx context.Context, path string, query url.Values, v any) error {
+	target := "http://daemon" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fmt.Errorf("create daemon request: %w", err)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("daemon request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		if body.Error == "" {
+			body.Error = resp.Status
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("daemon: %w: %s", errNotFound, body.Error)
+		}
+		return fmt.Errorf("daemon: %s", body.Error)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode daemon response: %w", err)
+	}
+
+	if failed := resp.Header.Get(server.FailedLeaguesHeader); failed != "" {
+		return partialResult(failed, query.Get("date"))
+	}
+	return nil
+}
+
+// partialResult rebuilds the partial result error of a response from the
+// leagues that failed in the daemon.
+func partialResult(failed, date string) *api.PartialResultError {
+	partial := &api.PartialResultError{Date: date}
+	for _, field := range strings.Split(failed, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			continue
+		}
+		partial.Failures = append(partial.Failures, &api.LeagueError{
+			LeagueID: id,
+			Kind:     api.FailureTransport,
+			Err:      errors.New("failed to load in the daemon"),
+		})
+	}
+	// The daemon answers 502 when every league fails, so at least one loaded
+	partial.Requests = len(partial.Failures) + 1
+	return partial
+}
+
+// dateParam formats a date for ?date= (in the configured timezone, like
+// the server parses it).
+func dateParam(date time.Time) string {
+	return date.In(data.Location()).Format("2006-01-02")
+}
@@ -0,0 +1,93 @@
+// Package daemon runs golazo headless and lets the TUI attach to it: the
+// daemon polls the live matches, keeps the provider's cache and sends the
+// notifications, serving the server package's API on a Unix socket in the
+// runtime directory. Client is an api.Provider reading from that socket, so
+// any number of TUIs share one poller.
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// SocketName is the name of the daemon's socket in the runtime directory.
+const SocketName = "daemon.sock"
+
+// dialTimeout bounds checking whether a daemon answers on a socket.
+const dialTimeout = 2 * time.Second
+
+// ErrRunning is returned by Listen when a daemon already holds the socket.
+var ErrRunning = errors.New("a daemon is already running")
+
+// errLocked is returned by lock when another process holds the lock.
+var errLocked = errors.New("locked")
+
+// SocketPath returns the path of the daemon's socket (see data.RuntimeDir).
+func SocketPath() (string, error) {
+	dir, err := data.RuntimeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SocketName), nil
+}
+
+// Listen listens on the socket at path, replacing one left behind by a
+// daemon that didn't shut down cleanly. The daemon holds a lock file next to
+// the socket until the listener is closed, so two daemons starting at once
+// can't both take over the socket.
+func Listen(path string) (net.Listener, error) {
+	lockFile, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open lock file: %w", err)
+	}
+	if err := lock(lockFile); err != nil {
+		lockFile.Close()
+		if errors.Is(err, errLocked) {
+			return nil, fmt.Errorf("%w on %s", ErrRunning, path)
+		}
+		return nil, fmt.Errorf("lock %s: %w", lockFile.Name(), err)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		lockFile.Close()
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		lockFile.Close()
+		return nil, fmt.Errorf("listen on %s: %w", path, err)
+	}
+	return &lockedListener{Listener: listener, lock: lockFile}, nil
+}
+
+// lockedListener releases the daemon's lock file when closed.
+type lockedListener struct {
+	net.Listener
+	lock *os.File
+}
+
+func (l *lockedListener) Close() error {
+	err := l.Listener.Close()
+	l.lock.Close()
+	return err
+}
+
+// Attach connects to the daemon on the default socket. It fails when none
+// is running.
+func Attach() (*Client, error) {
+	path, err := SocketPath()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	return Dial(ctx, path)
+}
@@ -0,0 +1,122 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/mock"
+	"github.com/0xjuanma/golazo/internal/server"
+)
+
+// partialProvider answers live matches with a failed league.
+type partialProvider struct {
+	api.Provider
+}
+
+func (p partialProvider) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	matches, _ := p.Provider.LiveMatches(ctx)
+	return matches, &api.PartialResultError{Requests: 3, Failures: []*api.LeagueError{{LeagueID: 87, Kind: api.FailureTimeout, Err: errors.New("timeout")}}}
+}
+
+// serve runs a daemon for the provider on a socket in a temporary directory
+// and returns its path.
+func serve(t *testing.T, client api.Provider, options server.Options) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), SocketName)
+	listener, err := Listen(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http.Server{Handler: server.New(client, options).Handler()}
+	go srv.Serve(listener)
+	t.Cleanup(func() { srv.Close() })
+	return path
+}
+
+func dial(t *testing.T, path string) *Client {
+	t.Helper()
+	c, err := Dial(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClient(t *testing.T) {
+	c := dial(t, serve(t, mock.NewClient(), server.Options{PollInterval: 5 * time.Second}))
+	ctx := context.Background()
+
+	if c.PollInterval() != 5*time.Second {
+		t.Errorf("PollInterval() = %v, want the daemon's 5s", c.PollInterval())
+	}
+	if live, err := c.LiveMatchesForceRefresh(ctx); err != nil || len(live) == 0 {
+		t.Errorf("LiveMatchesForceRefresh() = %d matches (%v), want the mock live matches", len(live), err)
+	}
+	if details, err := c.MatchDetails(ctx, 1001); err != nil || details == nil || details.ID != 1001 {
+		t.Errorf("MatchDetails(1001) = %v (%v), want match 1001", details, err)
+	}
+	if details, err := c.MatchDetails(ctx, 424242); err != nil || details != nil {
+		t.Errorf("MatchDetails(424242) = %v (%v), want nil like the provider", details, err)
+	}
+	if season, err := c.LeagueSeason(ctx, 47, ""); err != nil || season == nil || len(season.Matches) == 0 {
+		t.Errorf("LeagueSeason(47) = %v (%v), want the mock season", season, err)
+	}
+	if season, err := c.LeagueSeason(ctx, 87, ""); err != nil || season != nil {
+		t.Errorf("LeagueSeason(87) = %v (%v), want nil like the provider", season, err)
+	}
+	if table, err := c.LeagueTable(ctx, 47); err != nil || len(table) == 0 {
+		t.Errorf("LeagueTable(47) = %d entries (%v), want the mock table", len(table), err)
+	}
+	if _, err := c.SearchTeams(ctx, "arsenal"); err == nil {
+		t.Error("SearchTeams() succeeded, want an error: the mock can't search teams")
+	}
+}
+
+func TestClientPartialResults(t *testing.T) {
+	c := dial(t, serve(t, partialProvider{mock.NewClient()}, server.Options{}))
+
+	live, err := c.LiveMatches(context.Background())
+	partial, ok := api.AsPartialResult(err)
+	if !ok || partial.AllFailed() || len(partial.FailedLeagues()) != 1 || partial.FailedLeagues()[0] != 87 {
+		t.Fatalf("LiveMatches() error = %v, want a partial result naming league 87", err)
+	}
+	if len(live) == 0 {
+		t.Error("LiveMatches() dropped the matches that loaded")
+	}
+}
+
+func TestListen(t *testing.T) {
+	path := serve(t, mock.NewClient(), server.Options{})
+	if _, err := Listen(path); !errors.Is(err, ErrRunning) {
+		t.Errorf("Listen() on a running daemon's socket = %v, want ErrRunning", err)
+	}
+
+	// Left behind by a daemon that was killed
+	stale := filepath.Join(t.TempDir(), SocketName)
+	if err := os.WriteFile(stale, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Dial(context.Background(), stale); err == nil {
+		t.Error("Dial() succeeded with no daemon")
+	}
+	listener, err := Listen(stale)
+	if err != nil {
+		t.Fatalf("Listen() on a stale socket: %v", err)
+	}
+
+	// A second daemon starting alongside gives up before touching the socket
+	if _, err := Listen(stale); !errors.Is(err, ErrRunning) {
+		t.Errorf("second Listen() = %v, want ErrRunning", err)
+	}
+	listener.Close()
+	if listener, err = Listen(stale); err != nil {
+		t.Fatalf("Listen() after the first daemon stopped: %v", err)
+	}
+	listener.Close()
+}
@@ -0,0 +1,62 @@
+package data
+
+// FavouriteTeam is a team followed in every league it plays in. Matches
+// involving a favourite are pinned to the top of the match lists.
+type FavouriteTeam struct {
+	ID   int    `yaml:"id"`
+	Name string `yaml:"name"`
+	// Leagues are the competitions the team was picked from or seen in.
+	// They are fetched in favourites-only mode, in place of the selected
+	// leagues.
+	Leagues []int `yaml:"leagues,omitempty"`
+}
+
+// IsFavourite reports whether any of the teams is a favourite.
+func (s *Settings) IsFavourite(teamIDs ...int) bool {
+	for _, team := range s.FavouriteTeams {
+		if containsInt(teamIDs, team.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleFavourite adds a team to the favourites, or removes it if it is
+// already one. It returns true if the team was added.
+func (s *Settings) ToggleFavourite(team FavouriteTeam) bool {
+	for i, favourite := range s.FavouriteTeams {
+		if favourite.ID == team.ID {
+			s.FavouriteTeams = append(s.FavouriteTeams[:i], s.FavouriteTeams[i+1:]...)
+			return false
+		}
+	}
+	s.FavouriteTeams = append(s.FavouriteTeams, team)
+	return true
+}
+
+// AddFavouriteLeague records that a favourite team plays in a league, so
+// favourites-only mode fetches it. It returns false if nothing changed.
+func (s *Settings) AddFavouriteLeague(teamID, leagueID int) bool {
+	for i := range s.FavouriteTeams {
+		team := &s.FavouriteTeams[i]
+		if team.ID == teamID && leagueID != 0 && !containsInt(team.Leagues, leagueID) {
+			team.Leagues = append(team.Leagues, leagueID)
+			return true
+		}
+	}
+	return false
+}
+
+// FavouriteLeagueIDs returns the leagues the favourite teams play in, in the
+// order they were recorded.
+func (s *Settings) FavouriteLeagueIDs() []int {
+	var ids []int
+	for _, team := range s.FavouriteTeams {
+		for _, id := range team.Leagues {
+			if !containsInt(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
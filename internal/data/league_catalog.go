@@ -0,0 +1,115 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// LeagueCatalogFileName is the name of the cached league catalog in the cache directory.
+	LeagueCatalogFileName = "leagues.json"
+	// LeagueCatalogTTL is how long the cached catalog is considered fresh (7 days).
+	LeagueCatalogTTL = 7 * 24 * time.Hour
+)
+
+// LeagueCatalogData is the JSON structure stored on disk.
+type LeagueCatalogData struct {
+	Version   int          `json:"version"`
+	FetchedAt time.Time    `json:"fetched_at"`
+	Leagues   []LeagueInfo `json:"leagues"`
+}
+
+// leagueCatalogPath returns the path to the cached league catalog.
+func leagueCatalogPath() (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, LeagueCatalogFileName), nil
+}
+
+// LoadLeagueCatalog reads the cached league catalog from disk.
+// Returns an error if the file doesn't exist or can't be parsed.
+func LoadLeagueCatalog() (*LeagueCatalogData, error) {
+	path, err := leagueCatalogPath()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog LeagueCatalogData
+	if err := json.Unmarshal(raw, &catalog); err != nil {
+		return nil, err
+	}
+	return &catalog, nil
+}
+
+// SaveLeagueCatalog writes the league catalog to the cache directory.
+func SaveLeagueCatalog(leagues []LeagueInfo) error {
+	path, err := leagueCatalogPath()
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(LeagueCatalogData{
+		Version:   1,
+		FetchedAt: time.Now(),
+		Leagues:   leagues,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, 0644)
+}
+
+// IsFresh reports whether the catalog was fetched within LeagueCatalogTTL.
+func (c *LeagueCatalogData) IsFresh() bool {
+	return c != nil && len(c.Leagues) > 0 && time.Since(c.FetchedAt) < LeagueCatalogTTL
+}
+
+// LeagueCatalog returns every league the user can pick from, sorted by country.
+// Uses the cached FotMob catalog when available (even if stale), otherwise
+// falls back to the built-in AllSupportedLeagues list so settings work offline.
+func LeagueCatalog() []LeagueInfo {
+	var leagues []LeagueInfo
+	if catalog, err := LoadLeagueCatalog(); err == nil && len(catalog.Leagues) > 0 {
+		leagues = make([]LeagueInfo, len(catalog.Leagues))
+		copy(leagues, catalog.Leagues)
+	} else {
+		leagues = make([]LeagueInfo, len(AllSupportedLeagues))
+		copy(leagues, AllSupportedLeagues)
+	}
+
+	SortLeaguesByCountry(leagues)
+	return leagues
+}
+
+// SortLeaguesByCountry groups leagues by country (alphabetically), keeping
+// international competitions first and the original order within each country.
+func SortLeaguesByCountry(leagues []LeagueInfo) {
+	sort.SliceStable(leagues, func(i, j int) bool {
+		ci, cj := leagues[i].Country, leagues[j].Country
+		if isInternationalCountry(ci) != isInternationalCountry(cj) {
+			return isInternationalCountry(ci)
+		}
+		return ci < cj
+	})
+}
+
+// isInternationalCountry reports whether a "country" is actually a continental
+// or worldwide grouping (e.g., "International", "Europe").
+func isInternationalCountry(country string) bool {
+	switch country {
+	case "International", "Europe", "South America", "Africa", "Asia", "North America", "Oceania":
+		return true
+	}
+	return false
+}
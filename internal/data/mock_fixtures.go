@@ -0,0 +1,74 @@
+package data
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// mockSeasons are the seasons offered by MockLeagueSeason, newest first.
+var mockSeasons = []string{"2025/2026", "2024/2025"}
+
+// MockLeagueSeason returns a mock Premier League (47) season with three rounds:
+// two played and one upcoming. The previous season has every round finished.
+// Other leagues return nil.
+func MockLeagueSeason(leagueID int, season string) *api.LeagueSeason {
+	if leagueID != 47 {
+		return nil
+	}
+	if season == "" {
+		season = mockSeasons[0]
+	}
+
+	// Current season's next round is a week from now; past seasons are over
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -7)
+	upcomingRound := 3
+	if season != mockSeasons[0] {
+		start = start.AddDate(-1, 0, 0)
+		upcomingRound = 0
+	}
+
+	fixtures := [][2]int{{42, 49}, {50, 40}, {66, 33}, {10260, 10252}, {8678, 8466}}
+	names := map[int]string{
+		42: "Arsenal", 49: "Chelsea", 50: "Man City", 40: "Liverpool", 66: "Spurs",
+		33: "Man Utd", 10260: "Newcastle", 10252: "Aston Villa", 8678: "Bournemouth", 8466: "Southampton",
+	}
+	scores := [][2]int{{2, 1}, {1, 1}, {3, 0}, {0, 2}, {1, 0}}
+
+	var matches []api.Match
+	for round := 1; round <= 3; round++ {
+		day := start.AddDate(0, 0, (round-1)*7)
+		for i, pair := range fixtures {
+			home, away := pair[0], pair[1]
+			if round%2 == 0 {
+				home, away = away, home
+			}
+			kickoff := day.Add(time.Duration(12+i*2) * time.Hour)
+			match := api.Match{
+				ID:        9000000 + round*100 + i,
+				League:    api.League{ID: 47, Name: "Premier League", Country: "England"},
+				HomeTeam:  api.Team{ID: home, Name: names[home], ShortName: names[home]},
+				AwayTeam:  api.Team{ID: away, Name: names[away], ShortName: names[away]},
+				Status:    api.MatchStatusFinished,
+				MatchTime: &kickoff,
+				Round:     strconv.Itoa(round),
+			}
+			if round == upcomingRound {
+				match.Status = api.MatchStatusNotStarted
+			} else {
+				homeScore, awayScore := scores[(i+round)%len(scores)][0], scores[(i+round)%len(scores)][1]
+				match.HomeScore = &homeScore
+				match.AwayScore = &awayScore
+			}
+			matches = append(matches, match)
+		}
+	}
+
+	return &api.LeagueSeason{
+		League:  api.League{ID: 47, Name: "Premier League", Country: "England"},
+		Season:  season,
+		Seasons: mockSeasons,
+		Matches: matches,
+	}
+}
@@ -0,0 +1,63 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// Zone colors used by mock standings (match FotMob's legend colors).
+const (
+	mockZoneUCL        = "#2AD572"
+	mockZoneUEL        = "#0046A7"
+	mockZoneRelegation = "#FF4646"
+)
+
+// MockLeagueTable returns mock standings for a league.
+// Premier League (47) returns a single table with zones; Champions League (42)
+// returns two groups to exercise multi-table rendering. Other leagues return nil.
+func MockLeagueTable(leagueID int) []api.LeagueTableEntry {
+	switch leagueID {
+	case 47:
+		return []api.LeagueTableEntry{
+			mockTableEntry(1, 42, "Arsenal", 16, 11, 3, 2, 32, 12, 36, "", "Champions League", mockZoneUCL),
+			mockTableEntry(2, 50, "Man City", 16, 10, 3, 3, 35, 17, 33, "", "Champions League", mockZoneUCL),
+			mockTableEntry(3, 40, "Liverpool", 16, 9, 4, 3, 30, 18, 31, "", "Champions League", mockZoneUCL),
+			mockTableEntry(4, 49, "Chelsea", 16, 9, 3, 4, 29, 19, 30, "", "Champions League", mockZoneUCL),
+			mockTableEntry(5, 66, "Spurs", 16, 8, 3, 5, 28, 22, 27, "", "Europa League", mockZoneUEL),
+			mockTableEntry(6, 33, "Man Utd", 16, 7, 4, 5, 24, 21, 25, "", "", ""),
+			mockTableEntry(7, 10260, "Newcastle", 16, 7, 3, 6, 25, 22, 24, "", "", ""),
+			mockTableEntry(8, 10252, "Aston Villa", 16, 6, 5, 5, 22, 21, 23, "", "", ""),
+			mockTableEntry(9, 8678, "Bournemouth", 16, 4, 3, 9, 18, 30, 15, "", "Relegation", mockZoneRelegation),
+			mockTableEntry(10, 8466, "Southampton", 16, 2, 3, 11, 11, 33, 9, "", "Relegation", mockZoneRelegation),
+		}
+	case 42:
+		return []api.LeagueTableEntry{
+			mockTableEntry(1, 50, "Man City", 6, 5, 1, 0, 16, 4, 16, "Grp. A", "Knockout stage", mockZoneUCL),
+			mockTableEntry(2, 157, "Bayern", 6, 4, 1, 1, 12, 6, 13, "Grp. A", "Knockout stage", mockZoneUCL),
+			mockTableEntry(3, 9906, "Atletico", 6, 1, 2, 3, 5, 10, 5, "Grp. A", "Europa League", mockZoneUEL),
+			mockTableEntry(4, 8593, "Ajax", 6, 0, 0, 6, 3, 16, 0, "Grp. A", "", ""),
+			mockTableEntry(1, 8633, "Real Madrid", 6, 5, 0, 1, 14, 5, 15, "Grp. B", "Knockout stage", mockZoneUCL),
+			mockTableEntry(2, 9825, "Arsenal", 6, 4, 0, 2, 11, 7, 12, "Grp. B", "Knockout stage", mockZoneUCL),
+			mockTableEntry(3, 8634, "Barcelona", 6, 2, 1, 3, 9, 9, 7, "Grp. B", "Europa League", mockZoneUEL),
+			mockTableEntry(4, 9885, "Juventus", 6, 0, 1, 5, 3, 16, 1, "Grp. B", "", ""),
+		}
+	default:
+		return nil
+	}
+}
+
+// mockTableEntry builds a table entry with derived goal difference.
+func mockTableEntry(pos, teamID int, name string, played, won, drawn, lost, gf, ga, pts int, group, zone, zoneColor string) api.LeagueTableEntry {
+	return api.LeagueTableEntry{
+		Position:       pos,
+		Team:           api.Team{ID: teamID, Name: name, ShortName: name},
+		Played:         played,
+		Won:            won,
+		Drawn:          drawn,
+		Lost:           lost,
+		GoalsFor:       gf,
+		GoalsAgainst:   ga,
+		GoalDifference: gf - ga,
+		Points:         pts,
+		Group:          group,
+		Zone:           zone,
+		ZoneColor:      zoneColor,
+	}
+}
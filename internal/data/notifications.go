@@ -0,0 +1,280 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotificationEvent is a kind of match event notifications can be turned on
+// for, by its name in settings.yaml.
+type NotificationEvent string
+
+const (
+	NotifyGoal            NotificationEvent = "goal"
+	NotifyKickoff         NotificationEvent = "kickoff"
+	NotifyHalfTime        NotificationEvent = "half_time"
+	NotifyFullTime        NotificationEvent = "full_time"
+	NotifyRedCard         NotificationEvent = "red_card"
+	NotifyPenaltyAwarded  NotificationEvent = "penalty_awarded"
+	NotifyVAROverturn     NotificationEvent = "var_overturn"
+	NotifyLineupAnnounced NotificationEvent = "lineup_announced"
+)
+
+// NotificationEvents lists every notification event, in the order the
+// settings view shows them.
+var NotificationEvents = []NotificationEvent{
+	NotifyGoal,
+	NotifyKickoff,
+	NotifyHalfTime,
+	NotifyFullTime,
+	NotifyRedCard,
+	NotifyPenaltyAwarded,
+	NotifyVAROverturn,
+	NotifyLineupAnnounced,
+}
+
+// Label returns a readable name for the event ("Half-time").
+func (e NotificationEvent) Label() string {
+	switch e {
+	case NotifyGoal:
+		return "Goals"
+	case NotifyKickoff:
+		return "Kick-off"
+	case NotifyHalfTime:
+		return "Half-time"
+	case NotifyFullTime:
+		return "Full-time"
+	case NotifyRedCard:
+		return "Red cards"
+	case NotifyPenaltyAwarded:
+		return "Penalties"
+	case NotifyVAROverturn:
+		return "VAR overturns"
+	case NotifyLineupAnnounced:
+		return "Line-ups"
+	}
+	return string(e)
+}
+
+// NotificationSettings configures which match events are notified.
+type NotificationSettings struct {
+	// Rules select events by league, team or match. An event is notified when
+	// any enabled rule matches it. No rules means goals in every match.
+	Rules []NotificationRule `yaml:"rules,omitempty"`
+
+	// Backends send the notifications, all of them alongside each other.
+	// No backends means desktop notifications.
+	Backends []NotificationBackend `yaml:"backends,omitempty"`
+
+	// QuietHours silences notifications for part of the day.
+	QuietHours QuietHours `yaml:"quiet_hours,omitempty"`
+
+	// RateLimit caps how many notifications are sent; the rest are batched
+	// into digests.
+	RateLimit RateLimit `yaml:"rate_limit,omitempty"`
+}
+
+// QuietHours is a daily period, in the configured timezone, with no
+// notifications. Times are "HH:MM"; the period may span midnight
+// ("23:00" to "07:30"). Events during quiet hours are dropped, not sent later.
+type QuietHours struct {
+	Start string `yaml:"start,omitempty"`
+	End   string `yaml:"end,omitempty"`
+}
+
+// RateLimit caps notifications per match and overall within a window.
+// Zero values use the defaults; negative values remove the cap.
+type RateLimit struct {
+	PerMatch int           `yaml:"per_match,omitempty"` // Per match (default 3)
+	Global   int           `yaml:"global,omitempty"`    // Across matches (default 6)
+	Window   time.Duration `yaml:"window,omitempty"`    // Default 10m
+}
+
+// Rate limit defaults.
+const (
+	DefaultRateLimitPerMatch = 3
+	DefaultRateLimitGlobal   = 6
+	DefaultRateLimitWindow   = 10 * time.Minute
+)
+
+// Enabled reports whether quiet hours are set.
+func (q QuietHours) Enabled() bool {
+	return q.Start != "" && q.End != ""
+}
+
+// Contains reports whether t falls within the quiet hours. Unparseable times
+// disable quiet hours (see Validate).
+func (q QuietHours) Contains(t time.Time) bool {
+	start, errStart := parseClock(q.Start)
+	end, errEnd := parseClock(q.End)
+	if !q.Enabled() || errStart != nil || errEnd != nil || start == end {
+		return false
+	}
+	now := t.Hour()*60 + t.Minute()
+	if start < end {
+		return now >= start && now < end
+	}
+	// Spans midnight
+	return now >= start || now < end
+}
+
+// Validate checks the start and end times.
+func (q QuietHours) Validate() error {
+	if q.Start == "" && q.End == "" {
+		return nil
+	}
+	if _, err := parseClock(q.Start); err != nil {
+		return fmt.Errorf("quiet_hours.start: %w", err)
+	}
+	if _, err := parseClock(q.End); err != nil {
+		return fmt.Errorf("quiet_hours.end: %w", err)
+	}
+	return nil
+}
+
+// parseClock parses "HH:MM" into minutes since midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// WithDefaults returns the rate limit with zero values replaced by the defaults.
+func (r RateLimit) WithDefaults() RateLimit {
+	if r.PerMatch == 0 {
+		r.PerMatch = DefaultRateLimitPerMatch
+	}
+	if r.Global == 0 {
+		r.Global = DefaultRateLimitGlobal
+	}
+	if r.Window <= 0 {
+		r.Window = DefaultRateLimitWindow
+	}
+	return r
+}
+
+// NotificationBackend configures a way of sending notifications. Which
+// fields apply depends on Type (see internal/notify).
+type NotificationBackend struct {
+	Type string `yaml:"type"`           // desktop, webhook, ntfy, gotify or exec
+	Name string `yaml:"name,omitempty"` // Shown by "golazo notify test"; defaults to Type
+
+	URL      string            `yaml:"url,omitempty"`      // webhook, ntfy (topic URL) and gotify (server URL)
+	Format   string            `yaml:"format,omitempty"`   // webhook payload: json (default), slack or discord
+	Template string            `yaml:"template,omitempty"` // webhook: Go template for a custom payload
+	Headers  map[string]string `yaml:"headers,omitempty"`  // Extra HTTP headers
+	Token    string            `yaml:"token,omitempty"`    // ntfy access token or gotify app token
+	Priority int               `yaml:"priority,omitempty"` // ntfy (1-5) and gotify (0-10)
+
+	Command []string `yaml:"command,omitempty"` // exec: program and arguments
+
+	Attempts int           `yaml:"attempts,omitempty"` // Tries per notification, 1 to not retry (default 3)
+	Timeout  time.Duration `yaml:"timeout,omitempty"`  // Per try (default 10s)
+}
+
+// NotificationRule turns on notifications for some events in the matches it
+// covers. Filters left empty match everything; a match must pass every filter
+// that is set.
+type NotificationRule struct {
+	Name     string              `yaml:"name,omitempty"`
+	Disabled bool                `yaml:"disabled,omitempty"`
+	Events   []NotificationEvent `yaml:"events"`
+	Leagues  []int               `yaml:"leagues,omitempty"` // League IDs
+	Teams    []string            `yaml:"teams,omitempty"`   // Team names or IDs; either side matches
+	Matches  []int               `yaml:"matches,omitempty"` // Match IDs
+}
+
+// DefaultNotificationRules is used when settings.yaml has no rules:
+// goals in every followed match, as before rules existed.
+func DefaultNotificationRules() []NotificationRule {
+	return []NotificationRule{{Name: "All matches", Events: []NotificationEvent{NotifyGoal}}}
+}
+
+// NotificationRules returns the configured rules, or the default ones.
+func (s *Settings) NotificationRules() []NotificationRule {
+	if len(s.Notifications.Rules) == 0 {
+		return DefaultNotificationRules()
+	}
+	return s.Notifications.Rules
+}
+
+// Has reports whether the rule turns on event.
+func (r NotificationRule) Has(event NotificationEvent) bool {
+	for _, e := range r.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether a match passes the rule's filters. Teams match by
+// ID or, case-insensitively, by name or short name.
+func (r NotificationRule) Covers(matchID, leagueID int, teams ...TeamRef) bool {
+	if len(r.Matches) > 0 && !containsInt(r.Matches, matchID) {
+		return false
+	}
+	if len(r.Leagues) > 0 && !containsInt(r.Leagues, leagueID) {
+		return false
+	}
+	if len(r.Teams) == 0 {
+		return true
+	}
+	for _, want := range r.Teams {
+		for _, team := range teams {
+			if team.matches(want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TeamRef identifies a team for rule matching.
+type TeamRef struct {
+	ID        int
+	Name      string
+	ShortName string
+}
+
+func (t TeamRef) matches(want string) bool {
+	want = strings.TrimSpace(want)
+	if id, err := strconv.Atoi(want); err == nil {
+		return id == t.ID
+	}
+	return strings.EqualFold(want, t.Name) || (t.ShortName != "" && strings.EqualFold(want, t.ShortName))
+}
+
+// Summary describes the rule's filters, e.g., "Premier League · Arsenal",
+// or "Every followed match" when it has none.
+func (r NotificationRule) Summary() string {
+	var parts []string
+	for _, id := range r.Leagues {
+		name := "League " + strconv.Itoa(id)
+		if info, ok := LeagueInfoByID(id); ok {
+			name = info.Name
+		}
+		parts = append(parts, name)
+	}
+	parts = append(parts, r.Teams...)
+	for _, id := range r.Matches {
+		parts = append(parts, "Match "+strconv.Itoa(id))
+	}
+	if len(parts) == 0 {
+		return "Every followed match"
+	}
+	return strings.Join(parts, " · ")
+}
+
+func containsInt(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// NotifiedFileName is the name of the notified events log in the config directory.
+	NotifiedFileName = "notified.json"
+	// notifiedRetention is how long a match is remembered after its last
+	// notification; matches are over well before then.
+	notifiedRetention = 72 * time.Hour
+)
+
+// NotifiedLog records the match events already notified, keyed by match ID
+// and event key (e.g., "goal:1234"), so golazo doesn't notify them again
+// after a restart or from a second instance. It is safe for concurrent use.
+type NotifiedLog struct {
+	mu      sync.Mutex
+	path    string // "" keeps the log in memory only
+	matches map[int]*notifiedMatch
+}
+
+type notifiedMatch struct {
+	Events  []string  `json:"events"`
+	Updated time.Time `json:"updated"`
+}
+
+// LoadNotifiedLog reads the log from the config directory, dropping matches
+// not notified for a few days. A missing or unreadable file starts an empty
+// log; the error is returned only when the config directory is unavailable,
+// along with a log kept in memory.
+func LoadNotifiedLog() (*NotifiedLog, error) {
+	log := &NotifiedLog{matches: make(map[int]*notifiedMatch)}
+
+	dir, err := ConfigDir()
+	if err != nil {
+		return log, err
+	}
+	log.path = filepath.Join(dir, NotifiedFileName)
+
+	log.merge()
+	return log, nil
+}
+
+// NewMemoryNotifiedLog returns a log that isn't saved (e.g., for tests).
+func NewMemoryNotifiedLog() *NotifiedLog {
+	return &NotifiedLog{matches: make(map[int]*notifiedMatch)}
+}
+
+// Add records an event as notified and saves the log. It returns false if
+// the event was already recorded.
+func (l *NotifiedLog) Add(matchID int, key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Another instance may have notified it since
+	l.merge()
+
+	match := l.matches[matchID]
+	if match == nil {
+		match = &notifiedMatch{}
+		l.matches[matchID] = match
+	}
+	if containsString(match.Events, key) {
+		return false, nil
+	}
+	match.Events = append(match.Events, key)
+	match.Updated = time.Now()
+	return true, l.save()
+}
+
+// merge adds the events saved in the file to the log, dropping matches not
+// notified for a few days. The caller holds l.mu (or owns the log).
+func (l *NotifiedLog) merge() {
+	if l.path == "" {
+		return
+	}
+	raw, err := os.ReadFile(l.path)
+	if err != nil {
+		return
+	}
+	var saved map[int]*notifiedMatch
+	if err := json.Unmarshal(raw, &saved); err != nil {
+		return
+	}
+
+	for id, match := range saved {
+		if match == nil || time.Since(match.Updated) >= notifiedRetention {
+			continue
+		}
+		current := l.matches[id]
+		if current == nil {
+			l.matches[id] = match
+			continue
+		}
+		for _, event := range match.Events {
+			if !containsString(current.Events, event) {
+				current.Events = append(current.Events, event)
+			}
+		}
+		if match.Updated.After(current.Updated) {
+			current.Updated = match.Updated
+		}
+	}
+}
+
+// save drops matches not notified for a few days and writes the log,
+// replacing the file atomically so other instances never read it half
+// written. The caller holds l.mu.
+func (l *NotifiedLog) save() error {
+	for id, match := range l.matches {
+		if time.Since(match.Updated) >= notifiedRetention {
+			delete(l.matches, id)
+		}
+	}
+	if l.path == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(l.matches)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(l.path, raw, 0600)
+}
+
+func containsString(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package data
+
+// checkPrivateDir does nothing: outside Unix the temp directory belongs to
+// the user already.
+func checkPrivateDir(path string) error {
+	return nil
+}
@@ -0,0 +1,23 @@
+//go:build unix
+
+package data
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkPrivateDir makes sure path is a directory (not a link to one) owned
+// by the user with mode 0700, so no one else can replace or read what is in it.
+func checkPrivateDir(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("check runtime directory: %w", err)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !info.IsDir() || !ok || int(stat.Uid) != os.Getuid() || info.Mode().Perm() != 0700 {
+		return fmt.Errorf("runtime directory %s must be a directory owned by you with mode 0700", path)
+	}
+	return nil
+}
@@ -11,13 +11,14 @@ const settingsFileName = "settings.yaml"
 
 // LeagueInfo contains league metadata for display purposes.
 type LeagueInfo struct {
-	ID      int
-	Name    string
-	Country string
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
 }
 
-// AllSupportedLeagues contains all leagues that Golazo supports.
-// This is the source of truth for available leagues.
+// AllSupportedLeagues contains the built-in list of leagues.
+// The full catalog is fetched from FotMob (see LeagueCatalog); this list is
+// the offline fallback when no catalog has been cached yet.
 var AllSupportedLeagues = []LeagueInfo{
 	// Top 5 European Leagues
 	{ID: 47, Name: "Premier League", Country: "England"},
@@ -82,6 +83,46 @@ type Settings struct {
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// FavouriteTeams are pinned to the top of the match lists.
+	FavouriteTeams []FavouriteTeam `yaml:"favourite_teams,omitempty"`
+
+	// FavouritesOnly shows only the favourite teams' matches, fetched from
+	// the leagues they play in rather than the selected leagues.
+	FavouritesOnly bool `yaml:"favourites_only,omitempty"`
+
+	// Timezone is an IANA zone name (e.g., "America/New_York") used for
+	// "today", date ranges and kickoff times. Empty means the system zone.
+	Timezone string `yaml:"timezone,omitempty"`
+
+	// ClockFormat is "24h" (default) or "12h".
+	ClockFormat string `yaml:"clock_format,omitempty"`
+
+	// Provider selects the data source: "fotmob" (default) or "football-data".
+	Provider string `yaml:"provider,omitempty"`
+
+	// FootballData configures the football-data.org provider.
+	FootballData FootballDataSettings `yaml:"football_data,omitempty"`
+
+	// Mock configures the mock provider's simulated matches.
+	Mock MockSettings `yaml:"mock,omitempty"`
+
+	// Notifications selects the match events that are notified.
+	Notifications NotificationSettings `yaml:"notifications,omitempty"`
+}
+
+// FootballDataSettings holds football-data.org credentials.
+type FootballDataSettings struct {
+	// APIKey is the football-data.org token. Falls back to FOOTBALL_DATA_API_KEY.
+	APIKey string `yaml:"api_key,omitempty"`
+}
+
+// MockSettings configures the simulated matches played by the mock provider.
+type MockSettings struct {
+	// Scenarios are scenario files or directories; empty plays the built-in ones.
+	Scenarios []string `yaml:"scenarios,omitempty"`
+	// Speed is match minutes per real minute (0 = default).
+	Speed float64 `yaml:"speed,omitempty"`
 }
 
 // SettingsPath returns the path to the settings file.
@@ -143,9 +184,15 @@ var DefaultLeagueIDs = []int{
 }
 
 // GetActiveLeagueIDs returns the league IDs that should be used for API calls.
+// In favourites-only mode these are the leagues the favourite teams play in.
 // If no leagues are selected in settings, returns the default leagues (not all).
 func GetActiveLeagueIDs() []int {
 	settings, err := LoadSettings()
+	if err == nil && settings.FavouritesOnly {
+		if ids := settings.FavouriteLeagueIDs(); len(ids) > 0 {
+			return ids
+		}
+	}
 	if err != nil || len(settings.SelectedLeagues) == 0 {
 		// Return default leagues for efficient API usage
 		return DefaultLeagueIDs
@@ -163,6 +210,25 @@ func GetAllLeagueIDs() []int {
 	return ids
 }
 
+// LeagueInfoByID looks up league metadata by ID.
+// Checks the built-in list first, then the cached FotMob catalog.
+// Returns false if the league is in neither.
+func LeagueInfoByID(leagueID int) (LeagueInfo, bool) {
+	for _, league := range AllSupportedLeagues {
+		if league.ID == leagueID {
+			return league, true
+		}
+	}
+	if catalog, err := LoadLeagueCatalog(); err == nil {
+		for _, league := range catalog.Leagues {
+			if league.ID == leagueID {
+				return league, true
+			}
+		}
+	}
+	return LeagueInfo{}, false
+}
+
 // IsLeagueSelected checks if a league ID is in the selected list.
 func (s *Settings) IsLeagueSelected(leagueID int) bool {
 	for _, id := range s.SelectedLeagues {
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// StatusFileName is the name of the status line state in the cache directory.
+const StatusFileName = "status.json"
+
+// StatusState holds the live matches last fetched by "golazo status", so a
+// status bar refreshing every few seconds reuses them instead of requesting
+// them again.
+type StatusState struct {
+	// Key identifies what was fetched (provider and leagues); the state is
+	// only reused for the same key.
+	Key       string      `json:"key"`
+	CheckedAt time.Time   `json:"checked_at"` // Last fetch, successful or not
+	FetchedAt time.Time   `json:"fetched_at"` // Last successful fetch, zero if none
+	Matches   []api.Match `json:"matches"`
+	// Error is set when the last fetch failed; Matches are then the ones
+	// fetched before, and the failure isn't retried until the state expires.
+	Error string `json:"error,omitempty"`
+}
+
+// statusStatePath returns the path of the status state file.
+func statusStatePath() (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, StatusFileName), nil
+}
+
+// LoadStatusState reads the status state, nil when there is none or it
+// can't be read.
+func LoadStatusState() *StatusState {
+	path, err := statusStatePath()
+	if err != nil {
+		return nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var state StatusState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil
+	}
+	return &state
+}
+
+// SaveStatusState writes the status state, replacing the file atomically as
+// several bars may run "golazo status" at once.
+func SaveStatusState(state *StatusState) error {
+	path, err := statusStatePath()
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, raw, 0600)
+}
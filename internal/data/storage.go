@@ -3,7 +3,9 @@ package data
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -64,6 +66,53 @@ func CacheDir() (string, error) {
 	return cachePath, nil
 }
 
+// WriteFileAtomic writes a file through a temporary file renamed over it, so
+// the other golazo processes sharing it (TUIs, the daemon, status bars) never
+// read it half written.
+func WriteFileAtomic(path string, raw []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// RuntimeDir returns the path to the golazo runtime directory, for the
+// daemon's socket: $XDG_RUNTIME_DIR/golazo, or golazo-<uid> in the temp
+// directory when XDG_RUNTIME_DIR isn't set (macOS, Windows). Only the user
+// can access it.
+func RuntimeDir() (string, error) {
+	if xdgRuntime := os.Getenv("XDG_RUNTIME_DIR"); xdgRuntime != "" {
+		runtimePath := filepath.Join(xdgRuntime, "golazo")
+		if err := os.MkdirAll(runtimePath, 0700); err != nil {
+			return "", fmt.Errorf("create runtime directory: %w", err)
+		}
+		return runtimePath, nil
+	}
+
+	// Anyone can create the directory first in the shared temp directory
+	runtimePath := filepath.Join(os.TempDir(), fmt.Sprintf("golazo-%d", os.Getuid()))
+	if err := os.Mkdir(runtimePath, 0700); err != nil && !errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("create runtime directory: %w", err)
+	}
+	if err := checkPrivateDir(runtimePath); err != nil {
+		return "", err
+	}
+	return runtimePath, nil
+}
+
 // MockDataPath returns the path to the mock data file.
 func MockDataPath() (string, error) {
 	dir, err := ConfigDir()
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Clock formats for kickoff times.
+const (
+	Clock24h = "24h"
+	Clock12h = "12h"
+)
+
+// TimezoneChoices are the zones offered by the settings view, after the
+// system zone. Any IANA zone can also be set directly in settings.yaml.
+var TimezoneChoices = []string{
+	"UTC",
+	"Europe/London",
+	"Europe/Madrid",
+	"Europe/Berlin",
+	"Europe/Istanbul",
+	"Africa/Lagos",
+	"Asia/Riyadh",
+	"Asia/Kolkata",
+	"Asia/Singapore",
+	"Asia/Shanghai",
+	"Asia/Tokyo",
+	"Australia/Sydney",
+	"America/Sao_Paulo",
+	"America/Argentina/Buenos_Aires",
+	"America/Bogota",
+	"America/Mexico_City",
+	"America/New_York",
+	"America/Chicago",
+	"America/Denver",
+	"America/Los_Angeles",
+}
+
+// Display time preferences, applied from settings at startup and on save.
+var (
+	timeMu       sync.RWMutex
+	timeLocation = time.Local
+	timeClock12h bool
+	clockOffset  time.Duration // Shifts Now(), e.g., to the recording time when replaying a session
+)
+
+// TimeLocation returns the configured timezone.
+// Falls back to the system zone if unset or unknown.
+func (s *Settings) TimeLocation() *time.Location {
+	if s.Timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// Uses12HourClock reports whether kickoff times should use a 12-hour clock.
+func (s *Settings) Uses12HourClock() bool {
+	return s.ClockFormat == Clock12h
+}
+
+// ApplyTimeSettings makes the settings' timezone and clock format the ones
+// used for date bucketing and time rendering.
+func ApplyTimeSettings(settings *Settings) {
+	if settings == nil {
+		settings = &Settings{}
+	}
+	loc := settings.TimeLocation()
+
+	timeMu.Lock()
+	defer timeMu.Unlock()
+	timeLocation = loc
+	timeClock12h = settings.Uses12HourClock()
+}
+
+// Location returns the timezone used to decide which day a match is on.
+func Location() *time.Location {
+	timeMu.RLock()
+	defer timeMu.RUnlock()
+	return timeLocation
+}
+
+// SetClockOffset shifts the time returned by Now. Replayed sessions set it so
+// "today" and live clocks match the time the session was recorded.
+func SetClockOffset(offset time.Duration) {
+	timeMu.Lock()
+	defer timeMu.Unlock()
+	clockOffset = offset
+}
+
+// Now returns the current time in the configured timezone.
+func Now() time.Time {
+	timeMu.RLock()
+	loc, offset := timeLocation, clockOffset
+	timeMu.RUnlock()
+	return time.Now().Add(offset).In(loc)
+}
+
+// DateKey returns t's calendar date (YYYY-MM-DD) in the configured timezone.
+func DateKey(t time.Time) string {
+	return t.In(Location()).Format("2006-01-02")
+}
+
+// ParseDate parses a date given as YYYY-MM-DD in the configured timezone,
+// "" being today.
+func ParseDate(value string) (time.Time, error) {
+	if value == "" {
+		return Now(), nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", value, Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (expected YYYY-MM-DD)", value)
+	}
+	// Noon keeps the day the same whatever the provider does with the time
+	return date.Add(12 * time.Hour), nil
+}
+
+// FormatClock formats t as a time of day ("15:04" or "3:04 PM") in the
+// configured timezone.
+func FormatClock(t time.Time) string {
+	timeMu.RLock()
+	loc, clock12h := timeLocation, timeClock12h
+	timeMu.RUnlock()
+
+	if clock12h {
+		return t.In(loc).Format("3:04 PM")
+	}
+	return t.In(loc).Format("15:04")
+}
+
+// FormatDate formats t with layout in the configured timezone.
+// Use FormatClock for times of day so the 12h/24h setting is respected.
+func FormatDate(t time.Time, layout string) string {
+	return t.In(Location()).Format(layout)
+}
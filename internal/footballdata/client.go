@@ -0,0 +1,431 @@
+// Package footballdata implements api.Provider on top of the football-data.org v4 API.
+// It covers fewer competitions than FotMob (see competitions) and needs a free
+// API key, but is an official API with documented rate limits.
+package footballdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cache"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	baseURL = "https://api.football-data.org/v4"
+
+	// APIKeyEnv is the environment variable read when settings.yaml has no key.
+	APIKeyEnv = "FOOTBALL_DATA_API_KEY"
+)
+
+// errUnsupportedLeague marks leagues football-data.org doesn't cover.
+var errUnsupportedLeague = errors.New("not covered by football-data.org")
+
+// errNotFound marks a 404: the requested resource doesn't exist.
+var errNotFound = errors.New("not found")
+
+// DefaultRateLimitConfig matches the free tier (10 requests/minute).
+func DefaultRateLimitConfig() ratelimit.Config {
+	return ratelimit.Config{
+		Interval:    6 * time.Second,  // 10 requests/minute sustained
+		Burst:       10,               // A view's first requests go out immediately
+		BaseBackoff: 10 * time.Second, // The minute window resets slowly
+		MaxBackoff:  60 * time.Second,
+		MaxRetries:  2,
+	}
+}
+
+// Client implements the api.Provider interface for football-data.org.
+type Client struct {
+	httpClient  *http.Client
+	baseURL     string
+	apiKey      string
+	rateLimiter *ratelimit.Limiter
+	cache       *cache.Responses
+	requests    singleflight.Group // Collapses concurrent identical requests (e.g., per-league live loading)
+}
+
+var _ api.Provider = (*Client)(nil)
+
+// NewClient creates a football-data.org client authenticated with apiKey.
+func NewClient(apiKey string) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		baseURL:     baseURL,
+		apiKey:      apiKey,
+		rateLimiter: ratelimit.New(DefaultRateLimitConfig()),
+		cache:       cache.New(cache.DefaultConfig()),
+	}
+}
+
+// NewClientWithTransport creates a client whose requests go through transport
+// (e.g., an httprec Recorder or Replayer).
+func NewClientWithTransport(apiKey string, transport http.RoundTripper) *Client {
+	client := NewClient(apiKey)
+	client.httpClient.Transport = transport
+	return client
+}
+
+// getJSON performs a rate-limited, authenticated GET and decodes the JSON body into v.
+// 429 and 5xx responses are retried per the rate limiter's backoff.
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, v interface{}) error {
+	requestURL := c.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	host := requestURL
+	if parsed, err := url.Parse(requestURL); err == nil {
+		host = parsed.Host
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx, host); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("X-Auth-Token", c.apiKey)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if c.rateLimiter.Observe(host, resp, attempt) {
+			// Drain so the connection can be reused for the retry
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			continue
+		}
+
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			// Error bodies look like {"message": "...", "errorCode": 403}
+			var apiErr struct {
+				Message string `json:"message"`
+			}
+			err := fmt.Errorf("unexpected status code %d", resp.StatusCode)
+			if json.NewDecoder(resp.Body).Decode(&apiErr) == nil && apiErr.Message != "" {
+				err = fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, apiErr.Message)
+			}
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("%w: %w", errNotFound, err)
+			}
+			return err
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+		return nil
+	}
+}
+
+// activeCompetitions splits the active leagues into covered competition codes
+// and errors for the leagues football-data.org doesn't cover.
+func activeCompetitions() ([]string, []*api.LeagueError) {
+	var codes []string
+	var unsupported []*api.LeagueError
+	for _, leagueID := range data.GetActiveLeagueIDs() {
+		comp, ok := competitionByLeagueID(leagueID)
+		if !ok {
+			unsupported = append(unsupported, unsupportedLeague(leagueID))
+			continue
+		}
+		codes = append(codes, comp.Code)
+	}
+	return codes, unsupported
+}
+
+// unsupportedLeague returns the error for a league football-data.org doesn't cover.
+func unsupportedLeague(leagueID int) *api.LeagueError {
+	return &api.LeagueError{LeagueID: leagueID, Kind: api.FailureUnsupported, Err: errUnsupportedLeague}
+}
+
+// competitionFor returns the competition for a league ID, or an unsupported league error.
+func competitionFor(leagueID int) (competition, error) {
+	comp, ok := competitionByLeagueID(leagueID)
+	if !ok {
+		return competition{}, unsupportedLeague(leagueID)
+	}
+	return comp, nil
+}
+
+// matchesAround fetches the active competitions' matches from the day before
+// to the day after date. football-data.org filters by UTC dates, so the extra
+// days let callers bucket matches by calendar day in the configured timezone.
+func (c *Client) matchesAround(ctx context.Context, date time.Time, codes []string) ([]fdMatch, error) {
+	day := date.In(data.Location())
+	query := url.Values{}
+	query.Set("competitions", strings.Join(codes, ","))
+	query.Set("dateFrom", day.AddDate(0, 0, -1).Format("2006-01-02"))
+	query.Set("dateTo", day.AddDate(0, 0, 1).Format("2006-01-02"))
+
+	key := "matches?" + query.Encode()
+	result, err, _ := c.requests.Do(key, func() (interface{}, error) {
+		var response fdMatchesResponse
+		if err := c.getJSON(ctx, "/matches", query, &response); err != nil {
+			return nil, err
+		}
+		return response.Matches, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]fdMatch), nil
+}
+
+// MatchesByDate retrieves the active leagues' matches on a date (a calendar
+// day in the configured timezone) with a single request.
+// Active leagues football-data.org doesn't cover are reported in a
+// *api.PartialResultError alongside the matches that did load.
+func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	dateKey := data.DateKey(date)
+	codes, unsupported := activeCompetitions()
+	partial := func() error {
+		if len(unsupported) == 0 {
+			return nil
+		}
+		return &api.PartialResultError{Date: dateKey, Requests: len(codes) + len(unsupported), Failures: unsupported}
+	}
+
+	if len(codes) == 0 {
+		return nil, partial()
+	}
+
+	cacheKey := dateKey + "@" + data.Location().String() + ":" + strings.Join(codes, ",")
+	if cached, freshness := c.cache.Matches(cacheKey); freshness == cache.Fresh {
+		return cached, partial()
+	}
+
+	fdMatches, err := c.matchesAround(ctx, date, codes)
+	if err != nil {
+		return nil, fmt.Errorf("fetch matches for date %s: %w", dateKey, err)
+	}
+
+	var matches []api.Match
+	for _, fdm := range fdMatches {
+		if data.DateKey(fdm.UTCDate) == dateKey {
+			matches = append(matches, fdm.toAPIMatch())
+		}
+	}
+	sortByKickoff(matches)
+
+	c.cache.SetMatches(cacheKey, matches)
+	return matches, partial()
+}
+
+// ResultsByDate is MatchesByDate: one request returns every match of the day anyway.
+func (c *Client) ResultsByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.MatchesByDate(ctx, date)
+}
+
+// MatchDetails retrieves goals, cards, substitutions and line-ups of a match.
+func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	if cached, freshness := c.cache.Details(matchID); freshness == cache.Fresh {
+		return cached, nil
+	}
+
+	var response fdMatch
+	if err := c.getJSON(ctx, fmt.Sprintf("/matches/%d", matchID), nil, &response); errors.Is(err, errNotFound) {
+		return nil, nil // No such match
+	} else if err != nil {
+		return nil, fmt.Errorf("fetch match details for match %d: %w", matchID, err)
+	}
+
+	details := response.toAPIMatchDetails()
+	c.cache.SetDetails(matchID, details)
+	return details, nil
+}
+
+// MatchDetailsForceRefresh fetches match details, bypassing the cache.
+func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	c.cache.ClearMatchDetails(matchID)
+	return c.MatchDetails(ctx, matchID)
+}
+
+// LiveMatches retrieves the active leagues' matches in progress.
+// Matches that kicked off yesterday and are still running are included.
+func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	if cached, freshness := c.cache.LiveMatches(); freshness == cache.Fresh {
+		return cached, nil
+	}
+
+	codes, unsupported := activeCompetitions()
+	if len(codes) == 0 {
+		return nil, &api.PartialResultError{Date: data.DateKey(data.Now()), Requests: len(unsupported), Failures: unsupported}
+	}
+
+	fdMatches, err := c.matchesAround(ctx, data.Now(), codes)
+	if err != nil {
+		return nil, fmt.Errorf("fetch live matches: %w", err)
+	}
+
+	var live []api.Match
+	for _, fdm := range fdMatches {
+		if toAPIStatus(fdm.Status) == api.MatchStatusLive {
+			live = append(live, fdm.toAPIMatch())
+		}
+	}
+	sortByKickoff(live)
+
+	c.cache.SetLiveMatches(live)
+	return live, nil
+}
+
+// LiveMatchesForceRefresh fetches live matches, bypassing the cache.
+func (c *Client) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
+	c.cache.ClearLiveCache()
+	return c.LiveMatches(ctx)
+}
+
+// LiveMatchesForLeague returns the live matches of one league.
+// All leagues come from the same request, so loading league by league costs
+// one request in total rather than one per league.
+func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
+	if _, err := competitionFor(leagueID); err != nil {
+		return nil, err
+	}
+
+	matches, err := c.LiveMatches(ctx)
+	if err != nil && len(matches) == 0 {
+		return nil, fmt.Errorf("fetch league %d: %w", leagueID, err)
+	}
+
+	var live []api.Match
+	for _, match := range matches {
+		if match.League.ID == leagueID {
+			live = append(live, match)
+		}
+	}
+	return live, nil
+}
+
+// StoreLiveMatches caches a live matches list assembled league by league.
+func (c *Client) StoreLiveMatches(matches []api.Match) {
+	c.cache.SetLiveMatches(matches)
+}
+
+// Leagues returns the competitions football-data.org covers.
+func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
+	leagues := make([]api.League, len(competitions))
+	for i, comp := range competitions {
+		leagues[i] = comp.league()
+	}
+	return leagues, nil
+}
+
+// LeagueMatches retrieves all fixtures and results of a league's current season.
+func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
+	season, err := c.LeagueSeason(ctx, leagueID, "")
+	if err != nil {
+		return nil, err
+	}
+	return season.Matches, nil
+}
+
+// LeagueSeason retrieves every fixture and result of a league season.
+// Seasons are named like FotMob's ("2023/2024", or "2022" for tournaments);
+// an empty season returns the current one.
+func (c *Client) LeagueSeason(ctx context.Context, leagueID int, season string) (*api.LeagueSeason, error) {
+	comp, err := competitionFor(leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("%d:%s", leagueID, season)
+	if cached, freshness := c.cache.LeagueSeason(cacheKey); freshness == cache.Fresh {
+		return cached, nil
+	}
+
+	var info fdCompetitionResponse
+	if err := c.getJSON(ctx, "/competitions/"+comp.Code, nil, &info); err != nil {
+		return nil, fmt.Errorf("fetch league %d seasons: %w", leagueID, err)
+	}
+
+	query := url.Values{}
+	if season != "" {
+		query.Set("season", seasonParam(season))
+	}
+	var response fdMatchesResponse
+	if err := c.getJSON(ctx, "/competitions/"+comp.Code+"/matches", query, &response); err != nil {
+		return nil, fmt.Errorf("fetch league %d season %q: %w", leagueID, season, err)
+	}
+
+	result := &api.LeagueSeason{
+		League: comp.league(),
+		Season: season,
+	}
+	if result.Season == "" {
+		result.Season = info.CurrentSeason.name()
+	}
+	for _, s := range info.Seasons {
+		result.Seasons = append(result.Seasons, s.name())
+	}
+	for _, fdm := range response.Matches {
+		result.Matches = append(result.Matches, fdm.toAPIMatch())
+	}
+	sortByKickoff(result.Matches)
+
+	// A season other than the newest one is over - keep it for longer
+	past := season != "" && len(result.Seasons) > 0 && result.Seasons[0] != season
+	c.cache.SetLeagueSeason(cacheKey, result, past)
+
+	return result, nil
+}
+
+// LeagueTable retrieves the standings of a league. Competitions with several
+// tables (groups) return all of them, each entry labelled with its group.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	comp, err := competitionFor(leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response fdStandingsResponse
+	if err := c.getJSON(ctx, "/competitions/"+comp.Code+"/standings", nil, &response); err != nil {
+		return nil, fmt.Errorf("fetch league table for league %d: %w", leagueID, err)
+	}
+
+	var entries []api.LeagueTableEntry
+	for _, standing := range response.Standings {
+		if standing.Type != "TOTAL" {
+			continue
+		}
+		group := ""
+		if standing.Group != nil {
+			group = humanize(*standing.Group)
+		}
+		for _, row := range standing.Table {
+			entries = append(entries, row.toAPITableEntry(group))
+		}
+	}
+	return entries, nil
+}
+
+// sortByKickoff orders matches by kickoff time.
+func sortByKickoff(matches []api.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i].MatchTime, matches[j].MatchTime
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.Before(*b)
+	})
+}
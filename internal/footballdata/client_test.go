@@ -0,0 +1,310 @@
+package footballdata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const testAPIKey = "test-key"
+
+// fixtureServer serves testdata files by request path and records the requests.
+type fixtureServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func newFixtureServer(t *testing.T, routes map[string]string) *fixtureServer {
+	t.Helper()
+
+	fs := &fixtureServer{}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs.mu.Lock()
+		fs.requests = append(fs.requests, r)
+		fs.mu.Unlock()
+
+		if r.Header.Get("X-Auth-Token") != testAPIKey {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "The resource you are looking for is restricted.", "errorCode": 403}`))
+			return
+		}
+
+		file, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Resource not found", "errorCode": 404}`))
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Errorf("read fixture %s: %v", file, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+func (fs *fixtureServer) requestCount() int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return len(fs.requests)
+}
+
+func (fs *fixtureServer) lastRequest() *http.Request {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.requests[len(fs.requests)-1]
+}
+
+// setup isolates settings in a temp config dir, selecting Premier League,
+// Champions League and one league football-data.org doesn't cover, and
+// buckets dates in UTC.
+func setup(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	settings := &data.Settings{SelectedLeagues: []int{47, 42, 9227}, Timezone: "UTC"}
+	if err := data.SaveSettings(settings); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+	data.ApplyTimeSettings(settings)
+	t.Cleanup(func() { data.ApplyTimeSettings(nil) })
+}
+
+func newTestClient(server *fixtureServer, apiKey string) *Client {
+	client := NewClient(apiKey)
+	client.baseURL = server.URL
+	return client
+}
+
+func TestMatchesByDate(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{"/matches": "matches.json"})
+	client := newTestClient(server, testAPIKey)
+
+	date := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	matches, err := client.MatchesByDate(context.Background(), date)
+
+	partial, ok := api.AsPartialResult(err)
+	if !ok {
+		t.Fatalf("expected a partial result error for the unsupported league, got %v", err)
+	}
+	if len(partial.Failures) != 1 || partial.Failures[0].LeagueID != 9227 || partial.Failures[0].Kind != api.FailureUnsupported {
+		t.Errorf("unexpected failures: %v", partial)
+	}
+	if partial.AllFailed() {
+		t.Error("partial result should not report all leagues as failed")
+	}
+
+	query := server.lastRequest().URL.Query()
+	if got := query.Get("competitions"); got != "PL,CL" {
+		t.Errorf("competitions = %q, want PL,CL", got)
+	}
+	if query.Get("dateFrom") != "2025-03-14" || query.Get("dateTo") != "2025-03-16" {
+		t.Errorf("date range = %s..%s, want 2025-03-14..2025-03-16", query.Get("dateFrom"), query.Get("dateTo"))
+	}
+
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches on 2025-03-15, want 2", len(matches))
+	}
+
+	live := matches[0]
+	if live.ID != 497002 || live.Status != api.MatchStatusLive {
+		t.Errorf("first match = %d (%s), want 497002 (live)", live.ID, live.Status)
+	}
+	if live.League.ID != 47 {
+		t.Errorf("league ID = %d, want FotMob's 47", live.League.ID)
+	}
+	if live.HomeScore == nil || *live.HomeScore != 2 || live.AwayScore == nil || *live.AwayScore != 1 {
+		t.Errorf("score = %v-%v, want 2-1", live.HomeScore, live.AwayScore)
+	}
+	if live.LiveTime == nil || *live.LiveTime != "67'" {
+		t.Errorf("live time = %v, want 67'", live.LiveTime)
+	}
+	if live.Round != "29" || live.HomeTeam.ShortName != "Liverpool" {
+		t.Errorf("round = %q, home = %q", live.Round, live.HomeTeam.ShortName)
+	}
+
+	paused := matches[1]
+	if paused.LiveTime == nil || *paused.LiveTime != "HT" {
+		t.Errorf("paused live time = %v, want HT", paused.LiveTime)
+	}
+	if paused.Round != "Quarter Finals" || paused.League.ID != 42 {
+		t.Errorf("round = %q, league = %d", paused.Round, paused.League.ID)
+	}
+
+	// Cached: a second call doesn't hit the API
+	if _, err := client.ResultsByDate(context.Background(), date); err != nil {
+		if _, ok := api.AsPartialResult(err); !ok {
+			t.Fatalf("results by date: %v", err)
+		}
+	}
+	if n := server.requestCount(); n != 1 {
+		t.Errorf("made %d requests, want 1", n)
+	}
+}
+
+func TestLiveMatchesForLeague(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{"/matches": "matches.json"})
+	client := newTestClient(server, testAPIKey)
+	ctx := context.Background()
+
+	premierLeague, err := client.LiveMatchesForLeague(ctx, 47)
+	if err != nil {
+		t.Fatalf("live matches for league 47: %v", err)
+	}
+	championsLeague, err := client.LiveMatchesForLeague(ctx, 42)
+	if err != nil {
+		t.Fatalf("live matches for league 42: %v", err)
+	}
+	if len(premierLeague) != 1 || len(championsLeague) != 1 {
+		t.Errorf("got %d and %d live matches, want 1 and 1", len(premierLeague), len(championsLeague))
+	}
+	if n := server.requestCount(); n != 1 {
+		t.Errorf("made %d requests for two leagues, want 1", n)
+	}
+
+	_, err = client.LiveMatchesForLeague(ctx, 9227)
+	var leagueErr *api.LeagueError
+	if !errors.As(err, &leagueErr) || leagueErr.Kind != api.FailureUnsupported {
+		t.Errorf("expected unsupported league error, got %v", err)
+	}
+}
+
+func TestMatchDetails(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{"/matches/497100": "match.json"})
+	client := newTestClient(server, testAPIKey)
+
+	details, err := client.MatchDetails(context.Background(), 497100)
+	if err != nil {
+		t.Fatalf("match details: %v", err)
+	}
+
+	if details.Venue != "Anfield" || details.Referee != "István Kovács" || details.Attendance != 60312 {
+		t.Errorf("venue = %q, referee = %q, attendance = %d", details.Venue, details.Referee, details.Attendance)
+	}
+	if details.Winner == nil || *details.Winner != "away" {
+		t.Errorf("winner = %v, want away", details.Winner)
+	}
+	if !details.ExtraTime || details.Penalties == nil || *details.Penalties.Away != 4 {
+		t.Errorf("extra time = %v, penalties = %v", details.ExtraTime, details.Penalties)
+	}
+	if details.HalfTimeScore == nil || *details.HalfTimeScore.Away != 1 {
+		t.Errorf("half time score = %v", details.HalfTimeScore)
+	}
+	if details.Round != "Last 16" {
+		t.Errorf("round = %q, want Last 16", details.Round)
+	}
+
+	if len(details.Events) != 3 {
+		t.Fatalf("got %d events, want 3", len(details.Events))
+	}
+	goal, card, sub := details.Events[0], details.Events[1], details.Events[2]
+	if goal.Type != "goal" || *goal.Player != "Ousmane Dembélé" || goal.Assist != nil || goal.Minute != 12 {
+		t.Errorf("unexpected goal event: %+v", goal)
+	}
+	if card.Type != "card" || card.EventType == nil || *card.EventType != "yellow" {
+		t.Errorf("unexpected card event: %+v", card)
+	}
+	if sub.Type != "substitution" || *sub.Player != "Mohamed Salah" || *sub.Assist != "Darwin Núñez" {
+		t.Errorf("unexpected substitution event: %+v", sub)
+	}
+
+	if details.HomeFormation != "4-3-3" || len(details.HomeStarting) != 2 || len(details.HomeSubstitutes) != 1 {
+		t.Errorf("formation = %q, starting = %d, bench = %d", details.HomeFormation, len(details.HomeStarting), len(details.HomeSubstitutes))
+	}
+	if details.HomeStarting[1].Number != 11 || details.HomeLineup[1] != "Mohamed Salah" {
+		t.Errorf("unexpected line-up: %+v", details.HomeStarting[1])
+	}
+
+	// Unknown matches come back empty rather than failing
+	if details, err := client.MatchDetails(context.Background(), 999); details != nil || err != nil {
+		t.Errorf("unknown match = %v, %v; want nil without an error", details, err)
+	}
+}
+
+func TestLeagueTable(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{"/competitions/CL/standings": "standings.json"})
+	client := newTestClient(server, testAPIKey)
+
+	entries, err := client.LeagueTable(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("league table: %v", err)
+	}
+
+	// HOME/AWAY tables are skipped
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Group != "Group A" || entries[1].Group != "Group B" {
+		t.Errorf("groups = %q, %q", entries[0].Group, entries[1].Group)
+	}
+	if entries[1].Points != 13 || entries[1].GoalDifference != 12 || entries[1].Drawn != 1 {
+		t.Errorf("unexpected entry: %+v", entries[1])
+	}
+}
+
+func TestLeagueSeason(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{
+		"/competitions/PL":         "competition.json",
+		"/competitions/PL/matches": "competition_matches.json",
+	})
+	client := newTestClient(server, testAPIKey)
+
+	season, err := client.LeagueSeason(context.Background(), 47, "2023/2024")
+	if err != nil {
+		t.Fatalf("league season: %v", err)
+	}
+
+	if got := server.lastRequest().URL.Query().Get("season"); got != "2023" {
+		t.Errorf("season filter = %q, want 2023", got)
+	}
+	if len(season.Seasons) != 2 || season.Seasons[0] != "2024/2025" || season.Season != "2023/2024" {
+		t.Errorf("season = %q, seasons = %v", season.Season, season.Seasons)
+	}
+	if len(season.Matches) != 2 || season.Matches[0].ID != 435943 {
+		t.Errorf("matches not ordered by kickoff: %+v", season.Matches)
+	}
+	if rounds := season.Rounds(); len(rounds) != 1 || rounds[0].Name != "1" {
+		t.Errorf("unexpected rounds: %+v", rounds)
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	setup(t)
+	server := newFixtureServer(t, map[string]string{"/matches/1": "match.json"})
+
+	_, err := newTestClient(server, "wrong-key").MatchDetails(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "restricted") {
+		t.Errorf("expected 403 with the API message, got %v", err)
+	}
+
+	_, err = newTestClient(server, testAPIKey).LeagueTable(context.Background(), 9227)
+	var leagueErr *api.LeagueError
+	if !errors.As(err, &leagueErr) || leagueErr.Kind != api.FailureUnsupported {
+		t.Errorf("expected unsupported league error, got %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package footballdata
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// competition maps a FotMob league ID (what settings.yaml stores) to a
+// football-data.org competition code.
+type competition struct {
+	LeagueID int
+	Code     string
+	Name     string
+	Country  string
+}
+
+// competitions lists the competitions covered by football-data.org's free tier.
+var competitions = []competition{
+	{LeagueID: 47, Code: "PL", Name: "Premier League", Country: "England"},
+	{LeagueID: 48, Code: "ELC", Name: "EFL Championship", Country: "England"},
+	{LeagueID: 87, Code: "PD", Name: "La Liga", Country: "Spain"},
+	{LeagueID: 54, Code: "BL1", Name: "Bundesliga", Country: "Germany"},
+	{LeagueID: 55, Code: "SA", Name: "Serie A", Country: "Italy"},
+	{LeagueID: 53, Code: "FL1", Name: "Ligue 1", Country: "France"},
+	{LeagueID: 57, Code: "DED", Name: "Eredivisie", Country: "Netherlands"},
+	{LeagueID: 61, Code: "PPL", Name: "Primeira Liga", Country: "Portugal"},
+	{LeagueID: 42, Code: "CL", Name: "UEFA Champions League", Country: "Europe"},
+	{LeagueID: 50, Code: "EC", Name: "UEFA Euro", Country: "Europe"},
+	{LeagueID: 77, Code: "WC", Name: "FIFA World Cup", Country: "International"},
+	{LeagueID: 268, Code: "BSA", Name: "Brasileirão Série A", Country: "Brazil"},
+}
+
+// competitionByLeagueID looks up the competition for a FotMob league ID.
+func competitionByLeagueID(leagueID int) (competition, bool) {
+	for _, comp := range competitions {
+		if comp.LeagueID == leagueID {
+			return comp, true
+		}
+	}
+	return competition{}, false
+}
+
+// competitionByCode looks up the competition for a football-data.org code.
+func competitionByCode(code string) (competition, bool) {
+	for _, comp := range competitions {
+		if comp.Code == code {
+			return comp, true
+		}
+	}
+	return competition{}, false
+}
+
+// league returns the competition as an api.League with its FotMob ID.
+func (c competition) league() api.League {
+	return api.League{ID: c.LeagueID, Name: c.Name, Country: c.Country}
+}
@@ -0,0 +1,421 @@
+package footballdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// fdMatchesResponse is the response of /matches and /competitions/{code}/matches.
+type fdMatchesResponse struct {
+	Matches []fdMatch `json:"matches"`
+}
+
+// fdMatch is a match as returned by football-data.org v4.
+// Goals, bookings, substitutions and line-ups are only present on /matches/{id}
+// (and depend on the subscription).
+type fdMatch struct {
+	ID          int              `json:"id"`
+	UTCDate     time.Time        `json:"utcDate"`
+	Status      string           `json:"status"`
+	Minute      json.RawMessage  `json:"minute,omitempty"`     // Number or string ("45+2"), live matches only
+	InjuryTime  *int             `json:"injuryTime,omitempty"` // Added minutes being played, live matches only
+	Matchday    *int             `json:"matchday"`             // Nil in knockout rounds
+	Stage       string           `json:"stage"`                // e.g., "REGULAR_SEASON", "QUARTER_FINALS"
+	Venue       string           `json:"venue,omitempty"`      // Match details only
+	Attendance  int              `json:"attendance,omitempty"` // Match details only
+	Competition fdCompetitionRef `json:"competition"`
+	HomeTeam    fdTeam           `json:"homeTeam"`
+	AwayTeam    fdTeam           `json:"awayTeam"`
+	Score       fdScore          `json:"score"`
+	Referees    []fdReferee      `json:"referees"`
+
+	Goals         []fdGoal         `json:"goals,omitempty"`
+	Bookings      []fdBooking      `json:"bookings,omitempty"`
+	Substitutions []fdSubstitution `json:"substitutions,omitempty"`
+}
+
+type fdCompetitionRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+type fdTeam struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	ShortName string     `json:"shortName"`
+	TLA       string     `json:"tla"`
+	Crest     string     `json:"crest"`
+	Formation string     `json:"formation,omitempty"`
+	Lineup    []fdPlayer `json:"lineup,omitempty"`
+	Bench     []fdPlayer `json:"bench,omitempty"`
+}
+
+type fdPlayer struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Position    string `json:"position"`
+	ShirtNumber int    `json:"shirtNumber"`
+}
+
+type fdScorePair struct {
+	Home *int `json:"home"`
+	Away *int `json:"away"`
+}
+
+type fdScore struct {
+	Winner    *string      `json:"winner"`   // "HOME_TEAM", "AWAY_TEAM", "DRAW"
+	Duration  string       `json:"duration"` // "REGULAR", "EXTRA_TIME", "PENALTY_SHOOTOUT"
+	FullTime  fdScorePair  `json:"fullTime"` // Current score while live
+	HalfTime  fdScorePair  `json:"halfTime"`
+	Penalties *fdScorePair `json:"penalties,omitempty"`
+}
+
+type fdReferee struct {
+	Name string `json:"name"`
+	Type string `json:"type"` // "REFEREE", "ASSISTANT_REFEREE_N1", ...
+}
+
+type fdGoal struct {
+	Minute     int       `json:"minute"`
+	InjuryTime *int      `json:"injuryTime"`
+	Type       string    `json:"type"` // "REGULAR", "OWN", "PENALTY"
+	Team       fdTeam    `json:"team"`
+	Scorer     fdPlayer  `json:"scorer"`
+	Assist     *fdPlayer `json:"assist"`
+}
+
+type fdBooking struct {
+	Minute int      `json:"minute"`
+	Team   fdTeam   `json:"team"`
+	Player fdPlayer `json:"player"`
+	Card   string   `json:"card"` // "YELLOW", "YELLOW_RED", "RED"
+}
+
+type fdSubstitution struct {
+	Minute    int      `json:"minute"`
+	Team      fdTeam   `json:"team"`
+	PlayerOut fdPlayer `json:"playerOut"`
+	PlayerIn  fdPlayer `json:"playerIn"`
+}
+
+// fdStandingsResponse is the response of /competitions/{code}/standings.
+type fdStandingsResponse struct {
+	Standings []struct {
+		Stage string       `json:"stage"`
+		Type  string       `json:"type"`  // "TOTAL", "HOME", "AWAY"
+		Group *string      `json:"group"` // e.g., "GROUP_A"; nil for single-table leagues
+		Table []fdTableRow `json:"table"`
+	} `json:"standings"`
+}
+
+type fdTableRow struct {
+	Position       int    `json:"position"`
+	Team           fdTeam `json:"team"`
+	PlayedGames    int    `json:"playedGames"`
+	Won            int    `json:"won"`
+	Draw           int    `json:"draw"`
+	Lost           int    `json:"lost"`
+	Points         int    `json:"points"`
+	GoalsFor       int    `json:"goalsFor"`
+	GoalsAgainst   int    `json:"goalsAgainst"`
+	GoalDifference int    `json:"goalDifference"`
+}
+
+// fdCompetitionResponse is the response of /competitions/{code}.
+type fdCompetitionResponse struct {
+	CurrentSeason fdSeason   `json:"currentSeason"`
+	Seasons       []fdSeason `json:"seasons"` // Newest first
+}
+
+type fdSeason struct {
+	StartDate string `json:"startDate"` // YYYY-MM-DD
+	EndDate   string `json:"endDate"`
+}
+
+// name returns the season as shown in the fixtures browser:
+// "2024/2025" for seasons spanning two years, "2022" otherwise.
+func (s fdSeason) name() string {
+	start, end := yearOf(s.StartDate), yearOf(s.EndDate)
+	if end == "" || end == start {
+		return start
+	}
+	return start + "/" + end
+}
+
+// yearOf returns the year of a YYYY-MM-DD date.
+func yearOf(date string) string {
+	if len(date) < 4 {
+		return date
+	}
+	return date[:4]
+}
+
+// seasonParam converts a season name back to the API's season filter (start year).
+func seasonParam(name string) string {
+	return yearOf(name)
+}
+
+// toAPIStatus maps football-data.org statuses to api.MatchStatus.
+func toAPIStatus(status string) api.MatchStatus {
+	switch status {
+	case "IN_PLAY", "PAUSED", "LIVE", "EXTRA_TIME", "PENALTY_SHOOTOUT":
+		return api.MatchStatusLive
+	case "FINISHED", "AWARDED":
+		return api.MatchStatusFinished
+	case "POSTPONED", "SUSPENDED":
+		return api.MatchStatusPostponed
+	case "CANCELLED", "CANCELED":
+		return api.MatchStatusCancelled
+	default: // SCHEDULED, TIMED
+		return api.MatchStatusNotStarted
+	}
+}
+
+// toAPITeam converts a team.
+func (t fdTeam) toAPITeam() api.Team {
+	shortName := t.ShortName
+	if shortName == "" {
+		shortName = t.Name
+	}
+	return api.Team{ID: t.ID, Name: t.Name, ShortName: shortName, Logo: t.Crest}
+}
+
+// liveTime returns the clock shown for the match ("HT", "67'", "FT"), or nil.
+func (m fdMatch) liveTime() *string {
+	var clock string
+	switch m.Status {
+	case "PAUSED":
+		clock = "HT"
+	case "FINISHED", "AWARDED":
+		clock = "FT"
+	case "IN_PLAY", "LIVE", "EXTRA_TIME":
+		minute := strings.Trim(string(m.Minute), `"`)
+		if minute == "" || minute == "null" {
+			return nil
+		}
+		clock = minute + "'"
+		if m.InjuryTime != nil && *m.InjuryTime > 0 && !strings.Contains(minute, "+") {
+			clock = fmt.Sprintf("%s+%d'", minute, *m.InjuryTime)
+		}
+	case "PENALTY_SHOOTOUT":
+		clock = "Pen"
+	default:
+		return nil
+	}
+	return &clock
+}
+
+// round returns the matchday ("12") or a readable stage ("Quarter Finals").
+func (m fdMatch) round() string {
+	if m.Matchday != nil && (m.Stage == "" || m.Stage == "REGULAR_SEASON" || m.Stage == "LEAGUE_STAGE" || m.Stage == "GROUP_STAGE") {
+		return strconv.Itoa(*m.Matchday)
+	}
+	return humanize(m.Stage)
+}
+
+// toAPIMatch converts a match. The league uses FotMob's ID so settings and
+// league filters keep working.
+func (m fdMatch) toAPIMatch() api.Match {
+	league := api.League{Name: m.Competition.Name}
+	if comp, ok := competitionByCode(m.Competition.Code); ok {
+		league = comp.league()
+	}
+
+	kickoff := m.UTCDate
+	match := api.Match{
+		ID:        m.ID,
+		League:    league,
+		HomeTeam:  m.HomeTeam.toAPITeam(),
+		AwayTeam:  m.AwayTeam.toAPITeam(),
+		Status:    toAPIStatus(m.Status),
+		MatchTime: &kickoff,
+		LiveTime:  m.liveTime(),
+		Round:     m.round(),
+	}
+	if match.Status == api.MatchStatusLive || match.Status == api.MatchStatusFinished {
+		match.HomeScore = m.Score.FullTime.Home
+		match.AwayScore = m.Score.FullTime.Away
+	}
+	return match
+}
+
+// toAPIMatchDetails converts a match from /matches/{id}.
+func (m fdMatch) toAPIMatchDetails() *api.MatchDetails {
+	details := &api.MatchDetails{
+		Match:         m.toAPIMatch(),
+		Venue:         m.Venue,
+		Attendance:    m.Attendance,
+		MatchDuration: 90,
+		HomeFormation: m.HomeTeam.Formation,
+		AwayFormation: m.AwayTeam.Formation,
+	}
+
+	if m.Score.HalfTime.Home != nil || m.Score.HalfTime.Away != nil {
+		details.HalfTimeScore = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: m.Score.HalfTime.Home, Away: m.Score.HalfTime.Away}
+	}
+	if m.Score.Penalties != nil && (m.Score.Penalties.Home != nil || m.Score.Penalties.Away != nil) {
+		details.Penalties = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: m.Score.Penalties.Home, Away: m.Score.Penalties.Away}
+	}
+	if m.Score.Duration == "EXTRA_TIME" || m.Score.Duration == "PENALTY_SHOOTOUT" {
+		details.ExtraTime = true
+		details.MatchDuration = 120
+	}
+	if m.Score.Winner != nil {
+		switch *m.Score.Winner {
+		case "HOME_TEAM":
+			winner := "home"
+			details.Winner = &winner
+		case "AWAY_TEAM":
+			winner := "away"
+			details.Winner = &winner
+		}
+	}
+	for _, referee := range m.Referees {
+		if referee.Type == "REFEREE" {
+			details.Referee = referee.Name
+			break
+		}
+	}
+
+	details.Events = m.events()
+	details.HomeStarting, details.HomeLineup = toAPIPlayers(m.HomeTeam.Lineup)
+	details.AwayStarting, details.AwayLineup = toAPIPlayers(m.AwayTeam.Lineup)
+	details.HomeSubstitutes, _ = toAPIPlayers(m.HomeTeam.Bench)
+	details.AwaySubstitutes, _ = toAPIPlayers(m.AwayTeam.Bench)
+	return details
+}
+
+// Event ID ranges per kind. football-data.org has no event IDs, and an ID
+// must stay the same from one poll to the next so that new goals don't look
+// like edits of the bookings listed after them.
+const (
+	goalEventIDs         = 1
+	bookingEventIDs      = 1001
+	substitutionEventIDs = 2001
+)
+
+// events converts goals, bookings and substitutions into api.MatchEvents
+// in the shapes the live update parser expects.
+func (m fdMatch) events() []api.MatchEvent {
+	var events []api.MatchEvent
+	timestamp := m.UTCDate
+
+	for i, goal := range m.Goals {
+		player := goal.Scorer.Name
+		if goal.Type == "OWN" {
+			player += " (og)"
+		}
+		event := api.MatchEvent{
+			ID:        goalEventIDs + i,
+			Minute:    goal.Minute,
+			Type:      "goal",
+			Team:      goal.Team.toAPITeam(),
+			Player:    &player,
+			Timestamp: timestamp,
+		}
+		if goal.Type == "PENALTY" {
+			penalty := "penalty"
+			event.EventType = &penalty
+		}
+		if goal.Assist != nil && goal.Assist.Name != "" {
+			assist := goal.Assist.Name
+			event.Assist = &assist
+		}
+		events = append(events, event)
+	}
+
+	for i, booking := range m.Bookings {
+		player := booking.Player.Name
+		card := "yellow"
+		switch booking.Card {
+		case "RED":
+			card = "red"
+		case "YELLOW_RED":
+			card = "secondyellow"
+		}
+		events = append(events, api.MatchEvent{
+			ID:        bookingEventIDs + i,
+			Minute:    booking.Minute,
+			Type:      "card",
+			Team:      booking.Team.toAPITeam(),
+			Player:    &player,
+			EventType: &card,
+			Timestamp: timestamp,
+		})
+	}
+
+	for i, sub := range m.Substitutions {
+		// Player = going out, Assist = coming in (same convention as FotMob events)
+		out, in := sub.PlayerOut.Name, sub.PlayerIn.Name
+		events = append(events, api.MatchEvent{
+			ID:        substitutionEventIDs + i,
+			Minute:    sub.Minute,
+			Type:      "substitution",
+			Team:      sub.Team.toAPITeam(),
+			Player:    &out,
+			Assist:    &in,
+			Timestamp: timestamp,
+		})
+	}
+
+	return events
+}
+
+// toAPIPlayers converts a line-up, also returning the player names.
+func toAPIPlayers(players []fdPlayer) ([]api.PlayerInfo, []string) {
+	if len(players) == 0 {
+		return nil, nil
+	}
+	infos := make([]api.PlayerInfo, len(players))
+	names := make([]string, len(players))
+	for i, player := range players {
+		infos[i] = api.PlayerInfo{
+			ID:       player.ID,
+			Name:     player.Name,
+			Number:   player.ShirtNumber,
+			Position: player.Position,
+		}
+		names[i] = player.Name
+	}
+	return infos, names
+}
+
+// toAPITableEntry converts a standings row. group labels multi-table competitions.
+func (r fdTableRow) toAPITableEntry(group string) api.LeagueTableEntry {
+	return api.LeagueTableEntry{
+		Position:       r.Position,
+		Team:           r.Team.toAPITeam(),
+		Played:         r.PlayedGames,
+		Won:            r.Won,
+		Drawn:          r.Draw,
+		Lost:           r.Lost,
+		GoalsFor:       r.GoalsFor,
+		GoalsAgainst:   r.GoalsAgainst,
+		GoalDifference: r.GoalDifference,
+		Points:         r.Points,
+		Group:          group,
+	}
+}
+
+// humanize turns "QUARTER_FINALS" or "GROUP_A" into "Quarter Finals" / "Group A".
+func humanize(value string) string {
+	words := strings.Split(strings.ToLower(value), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -1,15 +1,23 @@
 package fotmob
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cache"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -27,43 +35,213 @@ func GetActiveLeagues() []int {
 // Use GetActiveLeagues() for dynamic league selection based on user preferences.
 var SupportedLeagues = data.GetAllLeagueIDs()
 
-// Client implements the api.Client interface for FotMob API
+// DefaultRateLimitConfig returns sensible defaults for FotMob.
+// The burst lets a view's concurrent league requests start immediately,
+// after which requests are spread at the steady-state interval.
+func DefaultRateLimitConfig() ratelimit.Config {
+	return ratelimit.Config{
+		Interval:    200 * time.Millisecond, // 5 requests/second sustained
+		Burst:       10,                     // Up to 10 requests back-to-back
+		BaseBackoff: 1 * time.Second,        // 1s, 2s, 4s, ... per host
+		MaxBackoff:  60 * time.Second,       // Never back off longer than a minute on our own
+		MaxRetries:  3,                      // Give up after 3 retries
+	}
+}
+
+// ClientStats is a snapshot of client health for debugging.
+type ClientStats struct {
+	RateLimit ratelimit.Stats     // Token bucket and per-host backoff state
+	Requests  RequestStats        // League payload reuse (requests saved)
+	Cache     cache.ResponseStats // Hit/miss/eviction counters per response cache
+}
+
+// Client implements the api.Provider interface for FotMob API
 type Client struct {
 	httpClient  *http.Client
 	baseURL     string
-	rateLimiter *RateLimiter
-	cache       *ResponseCache
+	rateLimiter *ratelimit.Limiter
+	cache       *cache.Responses
 	emptyCache  *EmptyResultsCache // Persistent cache for empty league+date combinations
+	disk        *DiskCache         // Raw league and match details responses, revalidated with ETag/Last-Modified
+	payloads    *leaguePayloads    // Short-lived league/tab payloads shared across dates
+	requests    requestCounters
+	refreshes   singleflight.Group // Background refreshes of stale cache entries
 }
 
+var _ api.Provider = (*Client)(nil)
+
 // NewClient creates a new FotMob API client with default configuration.
-// Includes minimal rate limiting (200ms between requests) for fast concurrent requests.
+// Rate limiting uses a token bucket (see DefaultRateLimitConfig) that allows a
+// burst of concurrent requests and backs off when FotMob throttles us.
 // Uses default caching configuration for improved performance.
-// Initializes persistent empty results cache to skip known empty league+date combinations.
+// Initializes persistent empty results cache to skip known empty league+date combinations,
+// and the disk cache of raw responses (see DiskCache).
 func NewClient() *Client {
+	c := NewClientWithTransport(nil)
+
 	// Initialize empty results cache (logs error but doesn't fail)
 	emptyCache, err := NewEmptyResultsCache()
-	if err != nil {
-		// If we can't create the cache, create client without it
-		emptyCache = nil
+	if err == nil {
+		c.emptyCache = emptyCache
 	}
 
+	// Same for the disk cache: without it every request goes to the network
+	disk, err := OpenDiskCache(DiskCacheMaxBytes)
+	if err == nil {
+		c.disk = disk
+	}
+
+	return c
+}
+
+// NewClientWithTransport creates a client whose requests go through transport
+// (e.g., an httprec Recorder or Replayer; nil for the default transport).
+// The disk and empty results caches are left out so every request reaches
+// the transport and the session on disk is self-contained.
+func NewClientWithTransport(transport http.RoundTripper) *Client {
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
+			Timeout:   15 * time.Second,
+			Transport: transport,
 		},
 		baseURL:     baseURL,
-		rateLimiter: NewRateLimiter(200 * time.Millisecond), // Minimal delay for concurrent requests
-		cache:       NewResponseCache(DefaultCacheConfig()),
-		emptyCache:  emptyCache,
+		rateLimiter: ratelimit.New(DefaultRateLimitConfig()),
+		cache:       cache.New(cache.DefaultConfig()),
+		payloads:    newLeaguePayloads(),
 	}
 }
 
 // Cache returns the response cache for external access (e.g., pre-fetching).
-func (c *Client) Cache() *ResponseCache {
+func (c *Client) Cache() *cache.Responses {
 	return c.cache
 }
 
+// Stats returns a snapshot of the client state, including whether FotMob is
+// currently throttling us (RateLimit.BackingOff).
+func (c *Client) Stats() ClientStats {
+	return ClientStats{
+		RateLimit: c.rateLimiter.Stats(),
+		Requests:  c.requests.snapshot(),
+		Cache:     c.cache.Stats(),
+	}
+}
+
+// backgroundRefreshTimeout bounds a refresh of stale cache data.
+const backgroundRefreshTimeout = 30 * time.Second
+
+// refreshInBackground runs fetch without blocking the caller, which is
+// serving stale cached data meanwhile. Refreshes of the same key already in
+// flight are joined instead of started again.
+func (c *Client) refreshInBackground(key string, fetch func(ctx context.Context) error) {
+	go func() {
+		_, _, _ = c.refreshes.Do(key, func() (interface{}, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+			defer cancel()
+			return nil, fetch(ctx)
+		})
+	}()
+}
+
+// get performs a rate-limited GET request with the headers FotMob expects.
+// 429 and 5xx responses are retried once the host's backoff has passed
+// (honouring Retry-After). When retries run out, the last response is
+// returned so callers can report its status code.
+func (c *Client) get(ctx context.Context, requestURL string) (*http.Response, error) {
+	return c.getWithHeaders(ctx, requestURL, nil)
+}
+
+// getCached is get backed by the disk cache. Final entries are served without
+// a request; other stored entries are revalidated with If-None-Match /
+// If-Modified-Since and served from disk on 304. Fresh 200 JSON responses are
+// stored for next time. Callers see a normal 200 response either way.
+func (c *Client) getCached(ctx context.Context, requestURL string) (*http.Response, error) {
+	if c.disk == nil {
+		return c.get(ctx, requestURL)
+	}
+
+	entry, body := c.disk.Lookup(requestURL)
+	if entry != nil && entry.Final {
+		return cachedResponse(body), nil
+	}
+
+	header := http.Header{}
+	if entry != nil {
+		if entry.ETag != "" {
+			header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := c.getWithHeaders(ctx, requestURL, header)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		c.disk.Revalidated(requestURL)
+		return cachedResponse(body), nil
+
+	case resp.StatusCode == http.StatusOK:
+		fresh, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read response: %w", err)
+		}
+		// Only keep bodies that parse, so a truncated response isn't revalidated forever
+		if json.Valid(fresh) {
+			_ = c.disk.Store(requestURL, resp.Header, fresh) // Best-effort
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(fresh))
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// getWithHeaders is get with extra request headers (e.g., conditional ones).
+func (c *Client) getWithHeaders(ctx context.Context, requestURL string, header http.Header) (*http.Response, error) {
+	host := requestURL
+	if parsed, err := url.Parse(requestURL); err == nil {
+		host = parsed.Host
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx, host); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCreateRequest, err)
+		}
+
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		for name, values := range header {
+			for _, value := range values {
+				req.Header.Add(name, value)
+			}
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !c.rateLimiter.Observe(host, resp, attempt) {
+			return resp, nil
+		}
+
+		// Drain so the connection can be reused for the retry
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+}
+
 // SaveEmptyCache persists the empty results cache to disk.
 // Should be called periodically or when the application exits.
 func (c *Client) SaveEmptyCache() error {
@@ -91,26 +269,55 @@ func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match
 	return c.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
 }
 
+// ResultsByDate retrieves a date's matches from the "results" tab only,
+// which is all past days need (finished matches).
+func (c *Client) ResultsByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.MatchesByDateWithTabs(ctx, date, []string{"results"})
+}
+
 // MatchesByDateWithTabs retrieves matches for a specific date, querying only specified tabs.
 // tabs can be: ["fixtures"], ["results"], or ["fixtures", "results"]
 // This allows optimizing API calls - e.g., only query "results" for past days.
-// Results are cached per date (cache key includes all tabs for that date).
+// Results are cached per date and tabs; stale results are returned right away
+// while a background refresh fetches new ones.
+//
+// Leagues that fail don't stop the others: the matches that did load are
+// returned together with a *api.PartialResultError listing each failed league,
+// tab and cause. Partial results are never cached.
 func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
-	// Normalize date to UTC for consistent comparison
-	requestDateStr := date.UTC().Format("2006-01-02")
+	// Dates are calendar days in the configured timezone, and results hold
+	// the active leagues only (they change with settings or favourites-only mode)
+	cacheKey := data.DateKey(date) + "@" + data.Location().String() + ":" + strings.Join(tabs, ",") + ":" + leaguesKey(GetActiveLeagues())
 
-	// Check cache first (only if querying both tabs - full cache)
-	if len(tabs) == 2 {
-		if cached := c.cache.Matches(requestDateStr); cached != nil {
-			return cached, nil
-		}
+	cached, freshness := c.cache.Matches(cacheKey)
+	switch freshness {
+	case cache.Fresh:
+		return cached, nil
+	case cache.Stale:
+		c.refreshInBackground("matches:"+cacheKey, func(ctx context.Context) error {
+			_, err := c.fetchMatchesByDate(ctx, date, tabs, cacheKey)
+			return err
+		})
+		return cached, nil
 	}
 
-	// Use a mutex to protect the shared slice
+	return c.fetchMatchesByDate(ctx, date, tabs, cacheKey)
+}
+
+// fetchMatchesByDate queries every active league for a date and caches the
+// result under cacheKey when all leagues answered. See MatchesByDateWithTabs.
+func (c *Client) fetchMatchesByDate(ctx context.Context, date time.Time, tabs []string, cacheKey string) ([]api.Match, error) {
+	requestDateStr := data.DateKey(date)
+
+	// Use a mutex to protect the shared slices
 	var mu sync.Mutex
 	var allMatches []api.Match
+	var failures []*api.LeagueError
+	requests := 0
 
 	// Query leagues concurrently - no stagger delays, just rate limiting
+	// Each league/tab payload covers the whole season, so repeated calls for
+	// other dates reuse it instead of hitting the network again
 	// Best-effort aggregation: if a league query fails, we skip it and continue with others
 	// This allows partial results even if some leagues are unavailable
 	var wg sync.WaitGroup
@@ -131,79 +338,23 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 				continue
 			}
 
+			requests++
 			wg.Add(1)
 			go func(id int, tabName string) {
 				defer wg.Done()
 
-				// Apply rate limiting (minimal delay for concurrent requests)
-				c.rateLimiter.Wait()
-
-				url := fmt.Sprintf("%s/leagues?id=%d&tab=%s", c.baseURL, id, tabName)
-
-				req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-				if err != nil {
-					// Skip this league on error - best effort aggregation
-					return
-				}
-
-				req.Header.Set("User-Agent", "Mozilla/5.0")
-
-				resp, err := c.httpClient.Do(req)
+				// Whole-season payload, shared across dates and concurrent callers
+				leagueAll, err := c.leagueTabMatches(ctx, id, tabName)
 				if err != nil {
-					// Skip this league on request error - best effort aggregation
-					return
-				}
-				defer resp.Body.Close()
-
-				var leagueResponse struct {
-					Details struct {
-						ID          int    `json:"id"`
-						Name        string `json:"name"`
-						Country     string `json:"country"`
-						CountryCode string `json:"countryCode,omitempty"`
-					} `json:"details"`
-					Fixtures struct {
-						AllMatches []fotmobMatch `json:"allMatches"`
-					} `json:"fixtures"`
-				}
-
-				if err := json.NewDecoder(resp.Body).Decode(&leagueResponse); err != nil {
-					// Skip this league on parse error - best effort aggregation
+					// Record and skip this league - best effort aggregation
+					mu.Lock()
+					failures = append(failures, asLeagueError(err, id, tabName))
+					mu.Unlock()
 					return
 				}
 
-				// Filter matches for the requested date and add league info
-				// Note: Matches are sorted chronologically, so we need to check all matches
-				var leagueMatches []api.Match
-				for _, m := range leagueResponse.Fixtures.AllMatches {
-					// Check if match is on the requested date
-					if m.Status.UTCTime != "" {
-						// Parse the UTC time - FotMob sometimes uses .000Z format
-						var matchTime time.Time
-						var err error
-						matchTime, err = time.Parse(time.RFC3339, m.Status.UTCTime)
-						if err != nil {
-							// Try alternative format with milliseconds (.000Z)
-							matchTime, err = time.Parse("2006-01-02T15:04:05.000Z", m.Status.UTCTime)
-						}
-						if err == nil {
-							// Compare dates in UTC to avoid timezone issues
-							matchDateStr := matchTime.UTC().Format("2006-01-02")
-							if matchDateStr == requestDateStr {
-								// Set league info from the response details
-								if m.League.ID == 0 {
-									m.League = league{
-										ID:          leagueResponse.Details.ID,
-										Name:        leagueResponse.Details.Name,
-										Country:     leagueResponse.Details.Country,
-										CountryCode: leagueResponse.Details.CountryCode,
-									}
-								}
-								leagueMatches = append(leagueMatches, m.toAPIMatch())
-							}
-						}
-					}
-				}
+				// Filter matches for the requested date
+				leagueMatches := matchesOnDate(leagueAll, requestDateStr)
 
 				// Mark league+date as empty if no matches found (for results tab only)
 				// This will be persisted to avoid future API calls
@@ -224,110 +375,85 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 
 	wg.Wait()
 
-	// Cache the results before returning
-	c.cache.SetMatches(requestDateStr, allMatches)
+	// Both tabs carry the whole season, so a match can come back once per tab
+	allMatches = uniqueMatches(allMatches)
 
 	// Persist empty results cache to disk (async, best-effort)
 	go c.SaveEmptyCache()
 
+	if len(failures) > 0 {
+		// Don't cache partial results - a transient failure would hide those leagues for the whole TTL
+		return allMatches, &api.PartialResultError{
+			Date:     requestDateStr,
+			Requests: requests,
+			Failures: failures,
+		}
+	}
+
+	// Cache the results before returning (skipped by live refreshes)
+	if cacheKey != "" {
+		c.cache.SetMatches(cacheKey, allMatches)
+	}
+
 	return allMatches, nil
 }
 
+// leaguesKey identifies a set of leagues in cache keys.
+func leaguesKey(leagueIDs []int) string {
+	ids := make([]string, len(leagueIDs))
+	for i, id := range leagueIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, ",")
+}
+
 // MatchesForLeagueAndDate fetches matches for a single league on a specific date.
 // Used for progressive loading - allows fetching one league at a time.
+// The league's payload is shared with other dates and callers (see leagueTabMatches).
 func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date time.Time, tab string) ([]api.Match, error) {
-	requestDateStr := date.UTC().Format("2006-01-02")
-
-	// Apply rate limiting
-	c.rateLimiter.Wait()
-
-	url := fmt.Sprintf("%s/leagues?id=%d&tab=%s", c.baseURL, leagueID, tab)
+	requestDateStr := data.DateKey(date)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request for league %d: %w", leagueID, err)
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0")
-
-	resp, err := c.httpClient.Do(req)
+	matches, err := c.leagueTabMatches(ctx, leagueID, tab)
 	if err != nil {
 		return nil, fmt.Errorf("fetch league %d: %w", leagueID, err)
 	}
-	defer resp.Body.Close()
 
-	var leagueResponse struct {
-		Details struct {
-			ID          int    `json:"id"`
-			Name        string `json:"name"`
-			Country     string `json:"country"`
-			CountryCode string `json:"countryCode,omitempty"`
-		} `json:"details"`
-		Fixtures struct {
-			AllMatches []fotmobMatch `json:"allMatches"`
-		} `json:"fixtures"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&leagueResponse); err != nil {
-		return nil, fmt.Errorf("decode league %d response: %w", leagueID, err)
-	}
-
-	// Filter matches for the requested date
-	var matches []api.Match
-	for _, m := range leagueResponse.Fixtures.AllMatches {
-		if m.Status.UTCTime != "" {
-			var matchTime time.Time
-			var parseErr error
-			matchTime, parseErr = time.Parse(time.RFC3339, m.Status.UTCTime)
-			if parseErr != nil {
-				matchTime, parseErr = time.Parse("2006-01-02T15:04:05.000Z", m.Status.UTCTime)
-			}
-			if parseErr == nil {
-				matchDateStr := matchTime.UTC().Format("2006-01-02")
-				if matchDateStr == requestDateStr {
-					if m.League.ID == 0 {
-						m.League = league{
-							ID:          leagueResponse.Details.ID,
-							Name:        leagueResponse.Details.Name,
-							Country:     leagueResponse.Details.Country,
-							CountryCode: leagueResponse.Details.CountryCode,
-						}
-					}
-					matches = append(matches, m.toAPIMatch())
-				}
-			}
-		}
-	}
-
-	return matches, nil
+	return matchesOnDate(matches, requestDateStr), nil
 }
 
 // MatchDetails retrieves detailed information about a specific match.
-// Results are cached to avoid redundant API calls.
+// Results are cached to avoid redundant API calls; stale details are returned
+// right away while a background refresh fetches new ones.
 func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	// Check cache first
-	if cached := c.cache.Details(matchID); cached != nil {
+	cached, freshness := c.cache.Details(matchID)
+	switch freshness {
+	case cache.Fresh:
+		return cached, nil
+	case cache.Stale:
+		c.refreshInBackground(fmt.Sprintf("details:%d", matchID), func(ctx context.Context) error {
+			_, err := c.fetchMatchDetails(ctx, matchID)
+			return err
+		})
 		return cached, nil
 	}
 
-	// Apply rate limiting
-	c.rateLimiter.Wait()
+	return c.fetchMatchDetails(ctx, matchID)
+}
 
+// fetchMatchDetails requests match details and caches them.
+func (c *Client) fetchMatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	url := fmt.Sprintf("%s/matchDetails?matchId=%d", c.baseURL, matchID)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request for match %d: %w", matchID, err)
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.getCached(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("fetch match details for match %d: %w", matchID, err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil // No such match
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code %d for match %d", resp.StatusCode, matchID)
 	}
@@ -340,6 +466,11 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 
 	details := response.toAPIMatchDetails()
 
+	// Finished matches never change: keep them on disk without revalidating
+	if details.Status == api.MatchStatusFinished && c.disk != nil {
+		_ = c.disk.MarkFinal(url) // Best-effort
+	}
+
 	// Cache the result
 	c.cache.SetDetails(matchID, details)
 
@@ -350,7 +481,7 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 // Use this for polling live matches to ensure fresh data.
 func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	c.cache.ClearMatchDetails(matchID)
-	return c.MatchDetails(ctx, matchID)
+	return c.fetchMatchDetails(ctx, matchID)
 }
 
 // BatchMatchDetails retrieves details for multiple matches concurrently.
@@ -401,7 +532,7 @@ func (c *Client) PreFetchMatchDetails(ctx context.Context, matchIDs []int, maxPr
 	// Filter out already cached matches
 	var uncachedIDs []int
 	for _, id := range matchIDs {
-		if c.cache.Details(id) == nil {
+		if !c.cache.HasFreshDetails(id) {
 			uncachedIDs = append(uncachedIDs, id)
 		}
 	}
@@ -416,37 +547,146 @@ func (c *Client) PreFetchMatchDetails(ctx context.Context, matchIDs []int, maxPr
 	}()
 }
 
-// Leagues retrieves available leagues.
+// Leagues retrieves the full FotMob league catalog, grouped by country.
+// The catalog rarely changes, so it is cached on disk (see data.LeagueCatalogTTL).
+// A fresh cached copy is returned without a request; if the request fails,
+// a stale cached copy is returned instead of an error.
 func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
-	// FotMob doesn't have a direct leagues endpoint, so we'll return an empty slice
-	// In a real implementation, you might need to maintain a list of known leagues
-	// or fetch them from a different endpoint
-	return []api.League{}, nil
+	cached, cacheErr := data.LoadLeagueCatalog()
+	if cacheErr == nil && cached.IsFresh() {
+		return leagueInfosToAPI(cached.Leagues), nil
+	}
+
+	leagues, err := c.fetchAllLeagues(ctx)
+	if err != nil {
+		if cacheErr == nil && len(cached.Leagues) > 0 {
+			return leagueInfosToAPI(cached.Leagues), nil
+		}
+		return nil, err
+	}
+
+	// Persist for next startup (best-effort)
+	_ = data.SaveLeagueCatalog(apiLeaguesToInfo(leagues))
+
+	return leagues, nil
 }
 
-// LeagueMatches retrieves matches for a specific league.
+// fetchAllLeagues requests the all-leagues listing from FotMob.
+func (c *Client) fetchAllLeagues(ctx context.Context) ([]api.League, error) {
+	url := fmt.Sprintf("%s/allLeagues", c.baseURL)
+
+	resp, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch league catalog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for league catalog", resp.StatusCode)
+	}
+
+	var response fotmobAllLeaguesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode league catalog response: %w", err)
+	}
+
+	leagues := response.toAPILeagues()
+	if len(leagues) == 0 {
+		return nil, fmt.Errorf("league catalog response contained no leagues")
+	}
+
+	return leagues, nil
+}
+
+// leagueInfosToAPI converts cached catalog entries to api.League values.
+func leagueInfosToAPI(infos []data.LeagueInfo) []api.League {
+	leagues := make([]api.League, len(infos))
+	for i, info := range infos {
+		leagues[i] = api.League{ID: info.ID, Name: info.Name, Country: info.Country}
+	}
+	return leagues
+}
+
+// apiLeaguesToInfo converts api.League values to catalog entries for the disk cache.
+func apiLeaguesToInfo(leagues []api.League) []data.LeagueInfo {
+	infos := make([]data.LeagueInfo, len(leagues))
+	for i, league := range leagues {
+		infos[i] = data.LeagueInfo{ID: league.ID, Name: league.Name, Country: league.Country}
+	}
+	return infos
+}
+
+// LeagueMatches retrieves all fixtures and results of a league's current season.
 func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
-	// This would require a different endpoint structure
-	// For now, we'll return an empty slice
-	// In a real implementation, you'd use: /api/leagues?id={leagueID}
-	return []api.Match{}, nil
+	season, err := c.LeagueSeason(ctx, leagueID, "")
+	if err != nil {
+		return nil, err
+	}
+	return season.Matches, nil
 }
 
-// LeagueTable retrieves the league table/standings for a specific league.
-func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
-	// Apply rate limiting
-	c.rateLimiter.Wait()
+// LeagueSeason retrieves every fixture and result of a league season.
+// season uses FotMob's format (e.g., "2023/2024", or "2022" for tournaments);
+// an empty season returns the current one. The response also lists all
+// seasons available for the league so callers can browse past seasons.
+// Past seasons are cached longer since their results won't change.
+func (c *Client) LeagueSeason(ctx context.Context, leagueID int, season string) (*api.LeagueSeason, error) {
+	cacheKey := fmt.Sprintf("%d:%s", leagueID, season)
+	cached, freshness := c.cache.LeagueSeason(cacheKey)
+	switch freshness {
+	case cache.Fresh:
+		return cached, nil
+	case cache.Stale:
+		c.refreshInBackground("season:"+cacheKey, func(ctx context.Context) error {
+			_, err := c.fetchLeagueSeason(ctx, leagueID, season)
+			return err
+		})
+		return cached, nil
+	}
 
-	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
+	return c.fetchLeagueSeason(ctx, leagueID, season)
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// fetchLeagueSeason requests a league season and caches it.
+func (c *Client) fetchLeagueSeason(ctx context.Context, leagueID int, season string) (*api.LeagueSeason, error) {
+	cacheKey := fmt.Sprintf("%d:%s", leagueID, season)
+
+	requestURL := fmt.Sprintf("%s/leagues?id=%d&tab=fixtures", c.baseURL, leagueID)
+	if season != "" {
+		requestURL += "&season=" + url.QueryEscape(season)
+	}
+
+	resp, err := c.get(ctx, requestURL)
 	if err != nil {
-		return nil, fmt.Errorf("create request for league %d table: %w", leagueID, err)
+		return nil, fmt.Errorf("fetch league %d season %q: %w", leagueID, season, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for league %d season %q", resp.StatusCode, leagueID, season)
+	}
+
+	var response fotmobLeagueSeasonResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode league %d season %q response: %w", leagueID, season, err)
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	result := response.toAPILeagueSeason(season)
 
-	resp, err := c.httpClient.Do(req)
+	// A season other than the newest one is over - keep it for longer
+	past := season != "" && len(result.Seasons) > 0 && result.Seasons[0] != season
+	c.cache.SetLeagueSeason(cacheKey, result, past)
+
+	return result, nil
+}
+
+// LeagueTable retrieves the league table/standings for a specific league.
+// Competitions with several tables (e.g., groups) return all of them flattened,
+// with each entry's Group set to the table it belongs to.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
+
+	resp, err := c.get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("fetch league table for league %d: %w", leagueID, err)
 	}
@@ -456,20 +696,11 @@ func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTab
 		return nil, fmt.Errorf("unexpected status code %d for league %d table", resp.StatusCode, leagueID)
 	}
 
-	var response struct {
-		Data struct {
-			Table []fotmobTableRow `json:"table"`
-		} `json:"data"`
-	}
+	var response fotmobLeagueTableResponse
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
 		return nil, fmt.Errorf("decode league table response for league %d: %w", leagueID, err)
 	}
 
-	entries := make([]api.LeagueTableEntry, 0, len(response.Data.Table))
-	for _, row := range response.Data.Table {
-		entries = append(entries, row.toAPITableEntry())
-	}
-
-	return entries, nil
+	return response.toAPITableEntries(), nil
 }
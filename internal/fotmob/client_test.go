@@ -0,0 +1,358 @@
+package fotmob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/fotmobfake"
+)
+
+var (
+	arsenal    = fotmobfake.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}
+	chelsea    = fotmobfake.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"}
+	liverpool  = fotmobfake.Team{ID: 8650, Name: "Liverpool", ShortName: "Liverpool"}
+	everton    = fotmobfake.Team{ID: 8668, Name: "Everton", ShortName: "Everton"}
+	realMadrid = fotmobfake.Team{ID: 8633, Name: "Real Madrid", ShortName: "Real Madrid"}
+	bayern     = fotmobfake.Team{ID: 9823, Name: "Bayern München", ShortName: "Bayern"}
+)
+
+// setup isolates settings in a temp config dir following the Premier League
+// and Champions League, with dates in UTC, and starts a fake with both leagues.
+func setup(t *testing.T) (*fotmobfake.Server, *fotmob.Client) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	settings := &data.Settings{SelectedLeagues: []int{47, 42}, Timezone: "UTC"}
+	if err := data.SaveSettings(settings); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+	data.ApplyTimeSettings(settings)
+	t.Cleanup(func() { data.ApplyTimeSettings(nil) })
+
+	fake := fotmobfake.New()
+	t.Cleanup(fake.Close)
+	fake.AddLeague(fotmobfake.League{ID: 47, Name: "Premier League", Country: "England", CountryCode: "ENG"})
+	fake.AddLeague(fotmobfake.League{ID: 42, Name: "Champions League", Country: "Europe", CountryCode: "INT"})
+
+	return fake, fotmob.NewClientWithTransport(fake.Transport())
+}
+
+// day returns hour:00 UTC, daysAgo days before today.
+func day(daysAgo, hour int) time.Time {
+	now := data.Now()
+	return time.Date(now.Year(), now.Month(), now.Day()-daysAgo, hour, 0, 0, 0, time.UTC)
+}
+
+func matchIDs(matches []api.Match) map[int]bool {
+	ids := make(map[int]bool, len(matches))
+	for _, match := range matches {
+		ids[match.ID] = true
+	}
+	return ids
+}
+
+func TestMatchesByDateWithTabs(t *testing.T) {
+	fake, client := setup(t)
+	ctx := context.Background()
+
+	fake.AddMatch(47, 1, arsenal, chelsea, day(1, 15)).Kickoff().Goal(fotmobfake.Home, "Saka", "").FullTime()
+	fake.AddMatch(47, 2, liverpool, everton, day(0, 12)).Kickoff().At(30)
+	fake.AddMatch(42, 3, realMadrid, bayern, day(0, 20))
+
+	today, err := client.MatchesByDateWithTabs(ctx, day(0, 12), []string{"fixtures", "results"})
+	if err != nil {
+		t.Fatalf("matches today: %v", err)
+	}
+	if ids := matchIDs(today); len(today) != 2 || !ids[2] || !ids[3] {
+		t.Errorf("today's matches = %v (%d), want 2 and 3 once each", ids, len(today))
+	}
+	requests := fake.Requests(fotmobfake.EndpointLeagues)
+	if requests != 4 {
+		t.Errorf("made %d league requests, want 4 (2 leagues x 2 tabs)", requests)
+	}
+
+	// Yesterday reuses the season payloads
+	yesterday, err := client.ResultsByDate(ctx, day(1, 12))
+	if err != nil {
+		t.Fatalf("results yesterday: %v", err)
+	}
+	if len(yesterday) != 1 || yesterday[0].Status != api.MatchStatusFinished || *yesterday[0].HomeScore != 1 {
+		t.Errorf("unexpected results yesterday: %+v", yesterday)
+	}
+	if n := fake.Requests(fotmobfake.EndpointLeagues); n != requests {
+		t.Errorf("yesterday made %d more league requests, want 0", n-requests)
+	}
+	if yesterday[0].League.ID != 47 || yesterday[0].League.Name != "Premier League" {
+		t.Errorf("league = %+v, want Premier League from the payload details", yesterday[0].League)
+	}
+}
+
+func TestMatchDetailsFollowsScriptedMatch(t *testing.T) {
+	fake, client := setup(t)
+	ctx := context.Background()
+	match := fake.AddMatch(47, 10, arsenal, chelsea, day(0, 15))
+
+	refresh := func() *api.MatchDetails {
+		t.Helper()
+		details, err := client.MatchDetailsForceRefresh(ctx, match.ID())
+		if err != nil {
+			t.Fatalf("match details: %v", err)
+		}
+		return details
+	}
+
+	if details := refresh(); details.Status != api.MatchStatusNotStarted || details.HomeScore == nil || *details.HomeScore != 0 {
+		t.Fatalf("before kickoff: status %s", details.Status)
+	}
+
+	match.Kickoff().At(23).Goal(fotmobfake.Home, "Saka", "Ødegaard").At(31).Card(fotmobfake.Away, "Caicedo", fotmobfake.CardYellow)
+	details := refresh()
+	if details.Status != api.MatchStatusLive || *details.LiveTime != "31'" {
+		t.Errorf("status = %s, live time = %v", details.Status, details.LiveTime)
+	}
+	if *details.HomeScore != 1 || *details.AwayScore != 0 {
+		t.Errorf("score = %d-%d, want 1-0", *details.HomeScore, *details.AwayScore)
+	}
+	if len(details.Events) != 2 {
+		t.Fatalf("got %d events, want 2", len(details.Events))
+	}
+	goal, card := details.Events[0], details.Events[1]
+	if goal.Type != "goal" || *goal.Player != "Saka" || *goal.Assist != "Ødegaard" || goal.Team.ID != arsenal.ID || goal.Minute != 23 {
+		t.Errorf("unexpected goal: %+v", goal)
+	}
+	if card.Type != "card" || *card.EventType != "yellow" || card.Team.ID != chelsea.ID {
+		t.Errorf("unexpected card: %+v", card)
+	}
+
+	match.HalfTime()
+	if details := refresh(); *details.LiveTime != "HT" || details.HalfTimeScore == nil || *details.HalfTimeScore.Home != 1 {
+		t.Errorf("half time: live time = %v, half-time score = %v", details.LiveTime, details.HalfTimeScore)
+	}
+
+	match.SecondHalf().At(60).Substitution(fotmobfake.Away, "Palmer", "Madueke").At(88).Goal(fotmobfake.Away, "Palmer", "").FullTime()
+	details = refresh()
+	if details.Status != api.MatchStatusFinished || details.Winner != nil {
+		t.Errorf("full time: status = %s, winner = %v", details.Status, details.Winner)
+	}
+	sub := details.Events[2]
+	if sub.Type != "substitution" || *sub.Player != "Madueke" || *sub.Assist != "Palmer" {
+		t.Errorf("unexpected substitution: %+v", sub)
+	}
+
+	// Finished details are served from the cache
+	before := fake.Requests(fotmobfake.EndpointMatchDetails)
+	if _, err := client.MatchDetails(ctx, match.ID()); err != nil {
+		t.Fatalf("cached details: %v", err)
+	}
+	if n := fake.Requests(fotmobfake.EndpointMatchDetails); n != before {
+		t.Errorf("cached details made %d requests", n-before)
+	}
+
+	// Unknown matches come back empty rather than failing
+	if details, err := client.MatchDetails(ctx, 999); details != nil || err != nil {
+		t.Errorf("unknown match = %v, %v; want nil without an error", details, err)
+	}
+}
+
+func TestMatchDetailsPenalties(t *testing.T) {
+	fake, client := setup(t)
+	match := fake.AddMatch(42, 20, realMadrid, bayern, day(0, 20))
+	match.Kickoff().At(50).Goal(fotmobfake.Away, "Kane", "").At(80).Goal(fotmobfake.Home, "Mbappé", "").At(108).Card(fotmobfake.Away, "Kimmich", fotmobfake.CardYellow).PenaltyShootout()
+
+	details, err := client.MatchDetailsForceRefresh(context.Background(), match.ID())
+	if err != nil {
+		t.Fatalf("match details: %v", err)
+	}
+	if details.Status != api.MatchStatusLive || details.LiveTime == nil || *details.LiveTime != "Pen" || !details.ExtraTime {
+		t.Errorf("shootout: status = %s, extra time = %v", details.Status, details.ExtraTime)
+	}
+
+	match.Penalties(4, 3)
+	details, err = client.MatchDetailsForceRefresh(context.Background(), match.ID())
+	if err != nil {
+		t.Fatalf("match details: %v", err)
+	}
+	if details.Penalties == nil || *details.Penalties.Home != 4 || *details.Penalties.Away != 3 {
+		t.Errorf("penalties = %v, want 4-3", details.Penalties)
+	}
+	if details.Winner == nil || *details.Winner != "home" {
+		t.Errorf("winner = %v, want home on penalties", details.Winner)
+	}
+	if details.HomeScore == nil || details.AwayScore == nil || *details.HomeScore != 1 || *details.AwayScore != 1 {
+		t.Errorf("score = %v-%v, want 1-1 (the shootout doesn't count)", details.HomeScore, details.AwayScore)
+	}
+}
+
+func TestLiveMatches(t *testing.T) {
+	fake, client := setup(t)
+	ctx := context.Background()
+	live := fake.AddMatch(47, 30, liverpool, everton, day(0, 12)).Kickoff().At(10)
+	fake.AddMatch(47, 31, arsenal, chelsea, day(0, 17))
+
+	matches, err := client.LiveMatches(ctx)
+	if err != nil {
+		t.Fatalf("live matches: %v", err)
+	}
+	if ids := matchIDs(matches); len(ids) != 1 || !ids[30] {
+		t.Errorf("live matches = %v, want 30", ids)
+	}
+
+	forLeague, err := client.LiveMatchesForLeague(ctx, 47)
+	if err != nil || len(forLeague) != 1 {
+		t.Errorf("live matches for league = %d (%v), want 1", len(forLeague), err)
+	}
+
+	live.FullTime()
+	matches, err = client.LiveMatchesForceRefresh(ctx)
+	if err != nil {
+		t.Fatalf("refresh live matches: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("got %d live matches after full time, want 0", len(matches))
+	}
+}
+
+func TestLeagueTable(t *testing.T) {
+	fake, client := setup(t)
+	fake.AddMatch(47, 40, arsenal, chelsea, day(3, 15)).Kickoff().Goal(fotmobfake.Home, "Saka", "").FullTime()
+	fake.AddMatch(47, 41, liverpool, everton, day(3, 15)).Kickoff().FullTime()
+	fake.AddMatch(47, 42, chelsea, liverpool, day(1, 15)).Kickoff().Goal(fotmobfake.Away, "Salah", "").Goal(fotmobfake.Away, "Salah", "").FullTime()
+
+	entries, err := client.LeagueTable(context.Background(), 47)
+	if err != nil {
+		t.Fatalf("league table: %v", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("got %d entries, want 4", len(entries))
+	}
+	first, last := entries[0], entries[3]
+	if first.Team.ID != liverpool.ID || first.Points != 4 || first.GoalsFor != 2 || first.Played != 2 {
+		t.Errorf("unexpected leader: %+v", first)
+	}
+	if last.Team.ID != chelsea.ID || last.Points != 0 || last.GoalDifference != -3 || last.Position != 4 {
+		t.Errorf("unexpected last place: %+v", last)
+	}
+}
+
+func TestRateLimitedRequestsAreRetried(t *testing.T) {
+	fake, client := setup(t)
+	fake.AddMatch(47, 50, arsenal, chelsea, day(0, 15))
+	fake.Inject(fotmobfake.EndpointMatchDetails, fotmobfake.FaultRateLimit, 2)
+
+	if _, err := client.MatchDetails(context.Background(), 50); err != nil {
+		t.Fatalf("match details after two 429s: %v", err)
+	}
+	if n := fake.Requests(fotmobfake.EndpointMatchDetails); n != 3 {
+		t.Errorf("made %d requests, want 3", n)
+	}
+	if stats := client.Stats().RateLimit; stats.Throttled != 2 || stats.Retries != 2 {
+		t.Errorf("throttled = %d, retries = %d, want 2 and 2", stats.Throttled, stats.Retries)
+	}
+}
+
+func TestFailuresAreReportedPerLeague(t *testing.T) {
+	fake, client := setup(t)
+	fake.AddMatch(47, 60, arsenal, chelsea, day(0, 15))
+	fake.AddMatch(42, 61, realMadrid, bayern, day(0, 20))
+	fake.Inject(fotmobfake.EndpointLeagues, fotmobfake.FaultMalformedJSON, 1)
+
+	matches, err := client.MatchesByDateWithTabs(context.Background(), day(0, 12), []string{"fixtures"})
+	partial, ok := api.AsPartialResult(err)
+	if !ok {
+		t.Fatalf("expected a partial result, got %v", err)
+	}
+	if len(partial.Failures) != 1 || partial.Failures[0].Kind != api.FailureSchema || partial.AllFailed() {
+		t.Errorf("unexpected failures: %v", partial)
+	}
+	if len(matches) != 1 {
+		t.Errorf("got %d matches from the healthy league, want 1", len(matches))
+	}
+
+	// Incomplete results aren't cached: the next call asks both leagues again
+	before := fake.Requests(fotmobfake.EndpointLeagues)
+	if _, err := client.LiveMatchesForceRefresh(context.Background()); err != nil {
+		t.Fatalf("live matches: %v", err)
+	}
+	if n := fake.Requests(fotmobfake.EndpointLeagues) - before; n != 2 {
+		t.Errorf("made %d league requests, want 2", n)
+	}
+}
+
+func TestSlowResponsesTimeOut(t *testing.T) {
+	fake, client := setup(t)
+	fake.SetLatency(500 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.MatchesByDateWithTabs(ctx, day(0, 12), []string{"fixtures"})
+	partial, ok := api.AsPartialResult(err)
+	if !ok || !partial.AllFailed() {
+		t.Fatalf("expected every league to fail, got %v", err)
+	}
+	for _, failure := range partial.Failures {
+		if failure.Kind != api.FailureTimeout || !errors.Is(failure, context.DeadlineExceeded) {
+			t.Errorf("failure kind = %s (%v), want timeout", failure.Kind, failure.Err)
+		}
+	}
+}
+
+func TestSharedRequestOutlivesACancelledCaller(t *testing.T) {
+	fake, client := setup(t)
+	fake.AddMatch(47, 70, arsenal, chelsea, day(0, 12)).Kickoff()
+	fake.SetLatency(100 * time.Millisecond)
+
+	// The first caller gives up while the request it started is in flight
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.LiveMatchesForLeague(ctx, 47); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("cancelled caller error = %v, want its deadline", err)
+	}
+
+	// The second joins that request and still gets its result
+	live, err := client.LiveMatchesForLeague(context.Background(), 47)
+	if err != nil || len(live) != 1 {
+		t.Fatalf("joining caller = %v (%v), want the live match", live, err)
+	}
+	if n := fake.Requests(fotmobfake.EndpointLeagues); n != 1 {
+		t.Errorf("made %d league requests, want 1 shared", n)
+	}
+}
+
+func TestLeagues(t *testing.T) {
+	_, client := setup(t)
+
+	leagues, err := client.Leagues(context.Background())
+	if err != nil {
+		t.Fatalf("leagues: %v", err)
+	}
+	if len(leagues) != 2 {
+		t.Errorf("got %d leagues, want 2", len(leagues))
+	}
+}
+
+func TestSearchTeams(t *testing.T) {
+	fake, client := setup(t)
+	fake.AddMatch(47, 50, arsenal, chelsea, day(1, 15))
+	fake.AddMatch(42, 51, realMadrid, arsenal, day(2, 20))
+
+	results, err := client.SearchTeams(context.Background(), "ars")
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(results) != 1 || results[0].Team.ID != arsenal.ID || results[0].League.ID != 47 || results[0].League.Name != "Premier League" {
+		t.Errorf("results = %+v, want Arsenal in the Premier League", results)
+	}
+
+	if results, err := client.SearchTeams(context.Background(), "  "); err != nil || len(results) != 0 {
+		t.Errorf("blank search = %v, %v; want nothing", results, err)
+	}
+}
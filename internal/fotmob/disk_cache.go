@@ -0,0 +1,392 @@
+package fotmob
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	// DiskCacheDirName is the directory under data.CacheDir() holding raw responses.
+	DiskCacheDirName = "http"
+	// DiskCacheMaxBytes is the default size cap for stored response bodies (100 MB).
+	DiskCacheMaxBytes = 100 << 20
+
+	diskCacheIndexName = "index.json"
+	diskCacheVersion   = 1
+
+	// accessSaveInterval is how stale an entry's saved access time can get
+	// before a lookup saves the index, so eviction knows what is still used
+	// without a write on every hit.
+	accessSaveInterval = time.Hour
+	// orphanGrace keeps bodies written this recently, whose index entry
+	// another process may be about to save.
+	orphanGrace = time.Minute
+)
+
+// DiskCache stores raw API responses on disk so they survive restarts.
+// Entries keep the ETag/Last-Modified validators from the response so they can
+// be revalidated with a conditional request (a 304 costs no body download).
+// Entries marked final (e.g., details of finished matches) never change and are
+// served without any request. When the total size exceeds the cap, the least
+// recently used entries are evicted, revalidatable ones before final ones.
+//
+// Every golazo process (the TUI, the daemon, one-off commands) opens its own
+// DiskCache on the same directory; each save merges the index on disk first,
+// so entries stored by the others are kept.
+type DiskCache struct {
+	mu       sync.Mutex
+	dir      string
+	maxBytes int64
+	index    diskCacheIndex
+}
+
+// diskCacheIndex is the JSON structure stored in index.json.
+// Bodies live next to it in <key>.json files.
+type diskCacheIndex struct {
+	Version int                        `json:"version"`
+	Entries map[string]*DiskCacheEntry `json:"entries"` // key: sha256 of the URL
+}
+
+// DiskCacheEntry describes one stored response.
+type DiskCacheEntry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Size         int64     `json:"size"`
+	StoredAt     time.Time `json:"stored_at"`   // Last time the body was downloaded or revalidated
+	AccessedAt   time.Time `json:"accessed_at"` // Last time the entry was used (for LRU eviction)
+	Final        bool      `json:"final,omitempty"`
+}
+
+// DiskCacheStats summarises the disk cache for `golazo cache stats`.
+type DiskCacheStats struct {
+	Dir      string
+	Entries  int
+	Final    int   // Entries served without revalidation
+	Bytes    int64 // Total size of stored bodies
+	MaxBytes int64
+	Oldest   time.Time // Oldest StoredAt (zero when empty)
+}
+
+// OpenDiskCache opens (or creates) the disk cache under data.CacheDir().
+// A missing or unreadable index starts an empty cache. Bodies the index
+// doesn't list (left by an unreadable index, or by a process that stopped
+// before saving its index) are removed once they are a minute old, so they
+// don't sit outside the size cap.
+func OpenDiskCache(maxBytes int64) (*DiskCache, error) {
+	cacheDir, err := data.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(cacheDir, DiskCacheDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create http cache directory: %w", err)
+	}
+
+	cache := &DiskCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		index:    diskCacheIndex{Version: diskCacheVersion, Entries: make(map[string]*DiskCacheEntry)},
+	}
+	if err := cache.load(); err != nil {
+		cache.index = diskCacheIndex{Version: diskCacheVersion, Entries: make(map[string]*DiskCacheEntry)}
+	}
+	cache.removeOrphans()
+	return cache, nil
+}
+
+// Dir returns the directory holding the cache.
+func (c *DiskCache) Dir() string {
+	return c.dir
+}
+
+// Lookup returns the entry and body stored for url, or nil if there is none
+// (or its body file went missing).
+func (c *DiskCache) Lookup(url string) (*DiskCacheEntry, []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := diskCacheKey(url)
+	entry, ok := c.index.Entries[key]
+	if !ok {
+		return nil, nil
+	}
+	body, err := os.ReadFile(c.bodyPath(key))
+	if err != nil {
+		delete(c.index.Entries, key)
+		return nil, nil
+	}
+	now := time.Now()
+	saved := entry.AccessedAt
+	entry.AccessedAt = now
+	if now.Sub(saved) >= accessSaveInterval {
+		_ = c.save() // Best-effort: only eviction order depends on it
+	}
+	copied := *entry
+	return &copied, body
+}
+
+// Store saves a 200 response body with its validators, evicting old entries
+// if the cache grows past its size cap. Responses without validators are
+// stored too so they can be marked final later.
+func (c *DiskCache) Store(url string, header http.Header, body []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := diskCacheKey(url)
+	if err := data.WriteFileAtomic(c.bodyPath(key), body, 0644); err != nil {
+		return fmt.Errorf("write cached response: %w", err)
+	}
+
+	c.merge()
+	now := time.Now()
+	final := false
+	if previous, ok := c.index.Entries[key]; ok {
+		final = previous.Final
+	}
+	c.index.Entries[key] = &DiskCacheEntry{
+		URL:          url,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		Size:         int64(len(body)),
+		StoredAt:     now,
+		AccessedAt:   now,
+		Final:        final,
+	}
+
+	c.evict(key)
+	return c.write()
+}
+
+// Revalidated records that the server confirmed the stored body is current (304).
+func (c *DiskCache) Revalidated(url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.index.Entries[diskCacheKey(url)]; ok {
+		entry.StoredAt = time.Now()
+		entry.AccessedAt = entry.StoredAt
+		_ = c.save() // Best-effort: the entry is only revalidated sooner
+	}
+}
+
+// MarkFinal flags the entry for url as never changing (e.g., a finished match),
+// so it is served from disk without revalidation.
+func (c *DiskCache) MarkFinal(url string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.index.Entries[diskCacheKey(url)]
+	if !ok || entry.Final {
+		return nil
+	}
+	entry.Final = true
+	return c.save()
+}
+
+// Clear removes every stored response.
+func (c *DiskCache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("remove http cache: %w", err)
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("create http cache directory: %w", err)
+	}
+	c.index.Entries = make(map[string]*DiskCacheEntry)
+	return nil
+}
+
+// Stats returns a summary of the cache contents.
+func (c *DiskCache) Stats() DiskCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := DiskCacheStats{Dir: c.dir, MaxBytes: c.maxBytes}
+	for _, entry := range c.index.Entries {
+		stats.Entries++
+		stats.Bytes += entry.Size
+		if entry.Final {
+			stats.Final++
+		}
+		if stats.Oldest.IsZero() || entry.StoredAt.Before(stats.Oldest) {
+			stats.Oldest = entry.StoredAt
+		}
+	}
+	return stats
+}
+
+// evict drops least recently used entries until the cache fits its cap (must hold lock).
+// Revalidatable entries go first since they can be fetched cheaply again;
+// keep is never evicted so the response just stored survives.
+func (c *DiskCache) evict(keep string) {
+	if c.maxBytes <= 0 {
+		return
+	}
+
+	var total int64
+	keys := make([]string, 0, len(c.index.Entries))
+	for key, entry := range c.index.Entries {
+		total += entry.Size
+		if key != keep {
+			keys = append(keys, key)
+		}
+	}
+	if total <= c.maxBytes {
+		return
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := c.index.Entries[keys[i]], c.index.Entries[keys[j]]
+		if a.Final != b.Final {
+			return !a.Final
+		}
+		return a.AccessedAt.Before(b.AccessedAt)
+	})
+
+	for _, key := range keys {
+		if total <= c.maxBytes {
+			break
+		}
+		total -= c.index.Entries[key].Size
+		_ = os.Remove(c.bodyPath(key))
+		delete(c.index.Entries, key)
+	}
+}
+
+// removeOrphans deletes the body files missing from the index.
+func (c *DiskCache) removeOrphans() {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		key, ok := strings.CutSuffix(file.Name(), ".json")
+		if !ok || len(key) != sha256.Size*2 {
+			continue // The index, or a file being written
+		}
+		if info, err := file.Info(); err != nil || time.Since(info.ModTime()) < orphanGrace {
+			continue
+		}
+		if _, listed := c.index.Entries[key]; !listed {
+			_ = os.Remove(filepath.Join(c.dir, file.Name()))
+		}
+	}
+}
+
+// load reads the index from disk.
+func (c *DiskCache) load() error {
+	index, err := c.read()
+	if err != nil {
+		return err
+	}
+	c.index = index
+	return nil
+}
+
+// read decodes the index on disk.
+func (c *DiskCache) read() (diskCacheIndex, error) {
+	raw, err := os.ReadFile(filepath.Join(c.dir, diskCacheIndexName))
+	if err != nil {
+		return diskCacheIndex{}, err
+	}
+	var index diskCacheIndex
+	if err := json.Unmarshal(raw, &index); err != nil {
+		return diskCacheIndex{}, err
+	}
+	if index.Version != diskCacheVersion || index.Entries == nil {
+		return diskCacheIndex{}, fmt.Errorf("http cache index version %d, want %d", index.Version, diskCacheVersion)
+	}
+	return index, nil
+}
+
+// merge adds the entries other processes saved since the index was read
+// (must hold lock). Entries whose body is gone were evicted, here or there,
+// and stay out; for entries in both, the latest download wins and the latest
+// access counts.
+func (c *DiskCache) merge() {
+	saved, err := c.read()
+	if err != nil {
+		return
+	}
+	for key, entry := range saved.Entries {
+		current, ok := c.index.Entries[key]
+		switch {
+		case !ok:
+			if _, err := os.Stat(c.bodyPath(key)); err == nil {
+				c.index.Entries[key] = entry
+			}
+		case entry.StoredAt.After(current.StoredAt):
+			entry.AccessedAt = latest(entry.AccessedAt, current.AccessedAt)
+			entry.Final = entry.Final || current.Final
+			c.index.Entries[key] = entry
+		default:
+			current.AccessedAt = latest(current.AccessedAt, entry.AccessedAt)
+			current.Final = current.Final || entry.Final
+		}
+	}
+}
+
+// latest returns the later of two times.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// save merges the index on disk and writes the result (must hold lock).
+func (c *DiskCache) save() error {
+	c.merge()
+	return c.write()
+}
+
+// write replaces the index on disk, atomically as every golazo process reads
+// it (must hold lock).
+func (c *DiskCache) write() error {
+	raw, err := json.Marshal(c.index)
+	if err != nil {
+		return err
+	}
+	return data.WriteFileAtomic(filepath.Join(c.dir, diskCacheIndexName), raw, 0644)
+}
+
+// bodyPath returns the file holding the body for key.
+func (c *DiskCache) bodyPath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// diskCacheKey derives a file-safe key from a URL.
+func diskCacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachedResponse builds a 200 response serving body from the disk cache.
+func cachedResponse(body []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
@@ -0,0 +1,124 @@
+package fotmob_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+func TestDiskCacheDropsBodiesMissingFromTheIndex(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	cache, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept := "https://www.fotmob.com/api/kept"
+	if err := cache.Store(kept, http.Header{"Etag": {`"v1"`}}, []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	// Left by a process that stopped before saving its index
+	orphan := filepath.Join(cache.Dir(), strings.Repeat("a", 64)+".json")
+	if err := os.WriteFile(orphan, []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	age(t, cache.Dir())
+
+	reopened, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, _ := reopened.Lookup(kept); entry == nil || entry.ETag != `"v1"` {
+		t.Errorf("Lookup(kept) = %+v, want the stored entry", entry)
+	}
+	if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+		t.Errorf("body missing from the index wasn't removed (%v)", err)
+	}
+}
+
+func TestDiskCacheIgnoresUnreadableIndex(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	cache, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Store("https://www.fotmob.com/api/a", http.Header{}, []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cache.Dir(), "index.json"), []byte(`{"version":`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	age(t, cache.Dir())
+
+	reopened, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats := reopened.Stats(); stats.Entries != 0 {
+		t.Errorf("entries = %d, want an empty cache", stats.Entries)
+	}
+	files, _ := os.ReadDir(cache.Dir())
+	if len(files) != 1 {
+		t.Errorf("cache holds %d files, want the index only (bodies it lost removed)", len(files))
+	}
+}
+
+func TestDiskCacheKeepsWhatOtherProcessesStored(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	// The daemon and the TUI, say, each with their own cache
+	daemon, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tui, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := "https://www.fotmob.com/api/first", "https://www.fotmob.com/api/second"
+	if err := daemon.Store(first, http.Header{}, []byte(`{"n":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tui.Store(second, http.Header{}, []byte(`{"n":2}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := daemon.MarkFinal(first); err != nil {
+		t.Fatal(err)
+	}
+	age(t, daemon.Dir())
+
+	reopened, err := fotmob.OpenDiskCache(fotmob.DiskCacheMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{first, second} {
+		if entry, body := reopened.Lookup(url); entry == nil || len(body) == 0 {
+			t.Errorf("Lookup(%s) = %+v, want the entry stored by its process", url, entry)
+		}
+	}
+	if entry, _ := reopened.Lookup(first); entry == nil || !entry.Final {
+		t.Errorf("Lookup(first) = %+v, want it final", entry)
+	}
+}
+
+// age backdates the files of a cache past the grace period new bodies get
+// before they count as orphans.
+func age(t *testing.T, dir string) {
+	t.Helper()
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-time.Hour)
+	for _, file := range files {
+		if err := os.Chtimes(filepath.Join(dir, file.Name()), past, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package fotmob
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
@@ -16,6 +17,8 @@ const (
 	EmptyCacheFileName = "empty-results.json"
 	// EmptyCacheExpiry is the duration after which empty results expire (7 days).
 	EmptyCacheExpiry = 7 * 24 * time.Hour
+	// emptyCacheVersion is bumped when the key format changes (v2: zone-aware dates).
+	emptyCacheVersion = 2
 )
 
 // EmptyResultsCache stores date+league combinations that returned 0 matches.
@@ -29,7 +32,7 @@ type EmptyResultsCache struct {
 // EmptyCacheData is the JSON structure stored on disk.
 type EmptyCacheData struct {
 	Version      int                        `json:"version"`
-	EmptyResults map[string]EmptyCacheEntry `json:"empty_results"` // key: "YYYY-MM-DD:leagueID"
+	EmptyResults map[string]EmptyCacheEntry `json:"empty_results"` // key: "YYYY-MM-DD@zone:leagueID"
 }
 
 // EmptyCacheEntry represents a cached empty result with expiration.
@@ -49,7 +52,7 @@ func NewEmptyResultsCache() (*EmptyResultsCache, error) {
 	cache := &EmptyResultsCache{
 		filePath: filepath.Join(configDir, EmptyCacheFileName),
 		data: EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheVersion,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		},
 	}
@@ -58,7 +61,7 @@ func NewEmptyResultsCache() (*EmptyResultsCache, error) {
 	if err := cache.load(); err != nil {
 		// If file doesn't exist or is corrupted, start fresh
 		cache.data = EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheVersion,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		}
 	}
@@ -119,7 +122,13 @@ func (c *EmptyResultsCache) load() error {
 		return err
 	}
 
-	return json.Unmarshal(data, &c.data)
+	if err := json.Unmarshal(data, &c.data); err != nil {
+		return err
+	}
+	if c.data.Version != emptyCacheVersion {
+		return fmt.Errorf("empty cache version %d, want %d", c.data.Version, emptyCacheVersion)
+	}
+	return nil
 }
 
 // cleanExpired removes expired entries from the cache.
@@ -136,8 +145,9 @@ func (c *EmptyResultsCache) cleanExpired() {
 }
 
 // makeKey creates a cache key from date and league ID.
+// Dates are days in the configured timezone, so the zone is part of the key.
 func (c *EmptyResultsCache) makeKey(date string, leagueID int) string {
-	return date + ":" + itoa(leagueID)
+	return date + "@" + data.Location().String() + ":" + itoa(leagueID)
 }
 
 // itoa converts an int to string without importing strconv.
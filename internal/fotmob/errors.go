@@ -0,0 +1,27 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// errCreateRequest marks errors from building a request (as opposed to sending it).
+var errCreateRequest = errors.New("create request")
+
+// failureKind classifies an error from Client.get.
+func failureKind(err error) api.FailureKind {
+	if errors.Is(err, errCreateRequest) {
+		return api.FailureRequest
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return api.FailureTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return api.FailureTimeout
+	}
+	return api.FailureTransport
+}
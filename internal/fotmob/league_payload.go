@@ -0,0 +1,243 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"golang.org/x/sync/singleflight"
+)
+
+// LeaguePayloadTTL is how long a league/tab payload is reused in memory.
+// A /leagues?id=X&tab=Y response contains the whole season, so one fetch can
+// serve every date a view asks for (e.g., the 5 days of the stats view).
+// Kept short so live scores in the fixtures tab stay fresh.
+const LeaguePayloadTTL = 1 * time.Minute
+
+// leaguePayload is a decoded league/tab response.
+type leaguePayload struct {
+	matches   []api.Match // Every match in the payload, with league info filled in
+	expiresAt time.Time
+}
+
+// leaguePayloads caches decoded league/tab payloads and collapses concurrent
+// identical requests into a single HTTP call.
+type leaguePayloads struct {
+	mu      sync.RWMutex
+	entries map[string]leaguePayload // key: "leagueID:tab"
+	group   singleflight.Group
+}
+
+// newLeaguePayloads creates an empty payload cache.
+func newLeaguePayloads() *leaguePayloads {
+	return &leaguePayloads{entries: make(map[string]leaguePayload)}
+}
+
+// get returns a cached payload, or nil if not cached or expired.
+func (p *leaguePayloads) get(key string) []api.Match {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	entry, ok := p.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil
+	}
+	return entry.matches
+}
+
+// set stores a payload, dropping expired entries.
+func (p *leaguePayloads) set(key string, matches []api.Match) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range p.entries {
+		if now.After(entry.expiresAt) {
+			delete(p.entries, k)
+		}
+	}
+	p.entries[key] = leaguePayload{matches: matches, expiresAt: now.Add(LeaguePayloadTTL)}
+}
+
+// clear drops every cached payload (e.g., before a forced refresh).
+func (p *leaguePayloads) clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries = make(map[string]leaguePayload)
+}
+
+// requestCounters tracks how many league/tab lookups hit the network.
+type requestCounters struct {
+	lookups   atomic.Int64 // League/tab payloads asked for
+	network   atomic.Int64 // HTTP requests actually made for them
+	shared    atomic.Int64 // Lookups that joined an in-flight request
+	cacheHits atomic.Int64 // Lookups served from the payload cache
+}
+
+// RequestStats reports how much work payload reuse saved.
+type RequestStats struct {
+	Lookups   int64 // League/tab payloads asked for (one request each without reuse)
+	Network   int64 // HTTP requests actually made
+	Shared    int64 // Lookups collapsed into an identical in-flight request
+	CacheHits int64 // Lookups served from the in-memory payload cache
+}
+
+// Saved returns the number of HTTP requests avoided.
+func (s RequestStats) Saved() int64 {
+	return s.Lookups - s.Network
+}
+
+// String formats the stats for debug output.
+func (s RequestStats) String() string {
+	return fmt.Sprintf("%d league lookups, %d HTTP requests (%d saved: %d shared in-flight, %d from cache)",
+		s.Lookups, s.Network, s.Saved(), s.Shared, s.CacheHits)
+}
+
+// snapshot returns the current counter values.
+func (r *requestCounters) snapshot() RequestStats {
+	return RequestStats{
+		Lookups:   r.lookups.Load(),
+		Network:   r.network.Load(),
+		Shared:    r.shared.Load(),
+		CacheHits: r.cacheHits.Load(),
+	}
+}
+
+// sharedFetchTimeout bounds a league/tab request shared by several callers,
+// which no single caller's context can cancel.
+const sharedFetchTimeout = 30 * time.Second
+
+// leagueTabMatches returns every match in a league's tab ("fixtures" or
+// "results") for the current season. The payload is fetched at most once per
+// LeaguePayloadTTL, and concurrent callers for the same league/tab share one
+// request. Each caller waits for it until its own ctx is done; the request
+// itself keeps going for the others. Errors are *api.LeagueError so callers
+// can report the cause.
+func (c *Client) leagueTabMatches(ctx context.Context, leagueID int, tab string) ([]api.Match, error) {
+	key := fmt.Sprintf("%d:%s", leagueID, tab)
+	c.requests.lookups.Add(1)
+
+	if cached := c.payloads.get(key); cached != nil {
+		c.requests.cacheHits.Add(1)
+		return cached, nil
+	}
+
+	results := c.payloads.group.DoChan(key, func() (interface{}, error) {
+		// Another caller may have filled the cache while we were queued
+		if cached := c.payloads.get(key); cached != nil {
+			return cached, nil
+		}
+
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedFetchTimeout)
+		defer cancel()
+		c.requests.network.Add(1)
+		matches, err := c.fetchLeagueTab(fetchCtx, leagueID, tab)
+		if err != nil {
+			return nil, err
+		}
+		c.payloads.set(key, matches)
+		return matches, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, &api.LeagueError{LeagueID: leagueID, Tab: tab, Kind: failureKind(ctx.Err()), Err: ctx.Err()}
+	case result := <-results:
+		if result.Shared {
+			c.requests.shared.Add(1)
+		}
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.([]api.Match), nil
+	}
+}
+
+// fetchLeagueTab requests and decodes one league/tab payload.
+func (c *Client) fetchLeagueTab(ctx context.Context, leagueID int, tab string) ([]api.Match, error) {
+	url := fmt.Sprintf("%s/leagues?id=%d&tab=%s", c.baseURL, leagueID, tab)
+
+	resp, err := c.getCached(ctx, url)
+	if err != nil {
+		return nil, &api.LeagueError{LeagueID: leagueID, Tab: tab, Kind: failureKind(err), Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &api.LeagueError{LeagueID: leagueID, Tab: tab, Kind: api.FailureStatus, StatusCode: resp.StatusCode,
+			Err: fmt.Errorf("unexpected status code %d", resp.StatusCode)}
+	}
+
+	var leagueResponse struct {
+		Details struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			Country     string `json:"country"`
+			CountryCode string `json:"countryCode,omitempty"`
+		} `json:"details"`
+		Fixtures struct {
+			AllMatches []fotmobMatch `json:"allMatches"`
+		} `json:"fixtures"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&leagueResponse); err != nil {
+		return nil, &api.LeagueError{LeagueID: leagueID, Tab: tab, Kind: api.FailureSchema, Err: err}
+	}
+
+	matches := make([]api.Match, 0, len(leagueResponse.Fixtures.AllMatches))
+	for _, m := range leagueResponse.Fixtures.AllMatches {
+		// Set league info from the response details
+		if m.League.ID == 0 {
+			m.League = league{
+				ID:          leagueResponse.Details.ID,
+				Name:        leagueResponse.Details.Name,
+				Country:     leagueResponse.Details.Country,
+				CountryCode: leagueResponse.Details.CountryCode,
+			}
+		}
+		matches = append(matches, m.toAPIMatch())
+	}
+	return matches, nil
+}
+
+// asLeagueError converts any error from leagueTabMatches to a *api.LeagueError.
+func asLeagueError(err error, leagueID int, tab string) *api.LeagueError {
+	var leagueErr *api.LeagueError
+	if errors.As(err, &leagueErr) {
+		return leagueErr
+	}
+	return &api.LeagueError{LeagueID: leagueID, Tab: tab, Kind: failureKind(err), Err: err}
+}
+
+// matchesOnDate returns the matches kicking off on dateStr (YYYY-MM-DD in the
+// configured timezone, see data.DateKey).
+func matchesOnDate(matches []api.Match, dateStr string) []api.Match {
+	var onDate []api.Match
+	for _, match := range matches {
+		if match.MatchTime != nil && data.DateKey(*match.MatchTime) == dateStr {
+			onDate = append(onDate, match)
+		}
+	}
+	return onDate
+}
+
+// uniqueMatches drops repeated matches, keeping the first of each ID.
+func uniqueMatches(matches []api.Match) []api.Match {
+	seen := make(map[int]bool, len(matches))
+	unique := matches[:0]
+	for _, match := range matches {
+		if seen[match.ID] {
+			continue
+		}
+		seen[match.ID] = true
+		unique = append(unique, match)
+	}
+	return unique
+}
@@ -5,28 +5,48 @@ import (
 	"fmt"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cache"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // LiveMatches retrieves all currently live matches for today.
 // Fetches matches from supported leagues and filters for those that have started but not finished.
 // Only queries "fixtures" tab since live matches are not in "results" (50% fewer API calls).
-// Results are cached for 2 minutes to avoid redundant fetches on quick navigation.
+// Results are cached for the live TTL to avoid redundant fetches on quick navigation;
+// stale results are returned right away while a background refresh runs.
+// If some leagues fail, the live matches that did load are returned with a
+// *api.PartialResultError and nothing is cached.
 func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
-	// Check cache first (2-min TTL for quick nav in/out)
-	if cached := c.cache.LiveMatches(); cached != nil {
+	// Check cache first (short TTL for quick nav in/out)
+	cached, freshness := c.cache.LiveMatches()
+	switch freshness {
+	case cache.Fresh:
+		return cached, nil
+	case cache.Stale:
+		c.refreshInBackground("live", func(ctx context.Context) error {
+			_, err := c.fetchLiveMatches(ctx)
+			return err
+		})
 		return cached, nil
 	}
 
-	today := time.Now()
+	return c.fetchLiveMatches(ctx)
+}
+
+// fetchLiveMatches queries today's fixtures of every active league and caches
+// the live ones.
+func (c *Client) fetchLiveMatches(ctx context.Context) ([]api.Match, error) {
+	today := data.Now()
 
 	// Only query "fixtures" tab - live matches are in fixtures, not results
 	// This reduces API calls from 28 (14 leagues × 2 tabs) to 14 (14 leagues × 1 tab)
-	matches, err := c.MatchesByDateWithTabs(ctx, today, []string{"fixtures"})
-	if err != nil {
-		return nil, fmt.Errorf("fetch matches for date %s: %w", today.Format("2006-01-02"), err)
+	// Bypasses the per-date cache so live scores are never older than the live cache
+	matches, err := c.fetchMatchesByDate(ctx, today, []string{"fixtures"}, "")
+	partial, isPartial := api.AsPartialResult(err)
+	if err != nil && (!isPartial || partial.AllFailed()) {
+		return nil, fmt.Errorf("fetch matches for date %s: %w", data.DateKey(today), err)
 	}
 
 	// Filter for live matches only (started but not finished)
@@ -37,40 +57,47 @@ func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
 		}
 	}
 
+	if isPartial {
+		// Don't cache an incomplete list
+		return liveMatches, err
+	}
+
 	// Cache the result
 	c.cache.SetLiveMatches(liveMatches)
 
 	return liveMatches, nil
 }
 
+// StoreLiveMatches caches a live matches list assembled elsewhere
+// (e.g., loaded league by league with LiveMatchesForLeague).
+func (c *Client) StoreLiveMatches(matches []api.Match) {
+	c.cache.SetLiveMatches(matches)
+}
+
 // LiveMatchesForceRefresh fetches live matches, bypassing the cache.
 // Use this for periodic refreshes to get the latest data.
 func (c *Client) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
 	c.cache.ClearLiveCache()
-	return c.LiveMatches(ctx)
+	c.payloads.clear() // League payloads carry live scores too
+	return c.fetchLiveMatches(ctx)
 }
 
 // LiveMatchesForLeague fetches live matches for a single league.
 // Used for progressive loading - results appear as each league responds.
+// The live status is authoritative, so matches that kicked off before
+// midnight (in the configured timezone) and are still running are kept.
 func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
-	today := time.Now()
-	dateStr := today.Format("2006-01-02")
-
 	// Fetch from API for this specific league
-	matches, err := c.MatchesForLeagueAndDate(ctx, leagueID, today, "fixtures")
+	matches, err := c.leagueTabMatches(ctx, leagueID, "fixtures")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fetch league %d: %w", leagueID, err)
 	}
 
 	// Filter for live matches only
 	var liveMatches []api.Match
 	for _, match := range matches {
-		// Verify match is for today and is live
-		if match.MatchTime != nil {
-			matchDate := match.MatchTime.UTC().Format("2006-01-02")
-			if matchDate == dateStr && match.Status == api.MatchStatusLive {
-				liveMatches = append(liveMatches, match)
-			}
+		if match.Status == api.MatchStatusLive {
+			liveMatches = append(liveMatches, match)
 		}
 	}
 
@@ -100,12 +127,13 @@ func NewLiveUpdateParser() *LiveUpdateParser {
 }
 
 // ParseEvents converts match events into human-readable update strings.
-// Events are sorted by minute in descending order (most recent first).
+// Events are sorted by minute in descending order (most recent first);
+// events in the same minute keep their order.
 func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
 	// Sort events by minute descending (most recent first)
 	sorted := make([]api.MatchEvent, len(events))
 	copy(sorted, events)
-	sort.Slice(sorted, func(i, j int) bool {
+	sort.SliceStable(sorted, func(i, j int) bool {
 		return sorted[i].Minute > sorted[j].Minute
 	})
 
@@ -129,6 +157,13 @@ const (
 	EventPrefixOther       = "·" // Small dot - other events (dim)
 )
 
+// Timeline entry types for changes that aren't match events (see matchdiff).
+// They are built by the app and formatted like events.
+const (
+	EventTypeDisallowed = "disallowed" // Player is the scorer of the withdrawn goal
+	EventTypeStatus     = "status"     // Player is the new phase ("Kick-off", "Half-time")
+)
+
 // formatEvent formats a single event into a readable string with symbol prefix and label.
 // Format: SYMBOL TIME' [LABEL] details [H] or [A]
 // Symbol prefixes are used by the UI to apply appropriate colors.
@@ -155,6 +190,9 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		if event.Assist != nil && *event.Assist != "" {
 			assistText = fmt.Sprintf(" (%s)", *event.Assist)
 		}
+		if event.EventType != nil && *event.EventType == "penalty" {
+			assistText = " (pen)"
+		}
 		return fmt.Sprintf("%s %d' [GOAL] %s%s %s", EventPrefixGoal, event.Minute, player, assistText, teamMarker)
 
 	case "card":
@@ -186,6 +224,41 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		// Using special markers for UI to color-code: {OUT} and {IN}
 		return fmt.Sprintf("%s %d' [SUB] {OUT}%s {IN}%s %s", EventPrefixSubstitution, event.Minute, playerOut, playerIn, teamMarker)
 
+	case "penalty":
+		// Shootout kick; EventType is "scored" or "missed"
+		player := "Unknown"
+		if event.Player != nil {
+			player = *event.Player
+		}
+		result := ""
+		if event.EventType != nil {
+			result = " " + *event.EventType
+		}
+		return fmt.Sprintf("%s %d' [PEN] %s%s %s", EventPrefixOther, event.Minute, player, result, teamMarker)
+
+	case "missedpenalty":
+		player := "Unknown"
+		if event.Player != nil {
+			player = *event.Player
+		}
+		return fmt.Sprintf("%s %d' [PEN] %s missed %s", EventPrefixOther, event.Minute, player, teamMarker)
+
+	case EventTypeDisallowed:
+		// Goal taken back after a VAR review
+		player := "Goal"
+		if event.Player != nil {
+			player = *event.Player
+		}
+		return fmt.Sprintf("%s %d' [VAR] %s - goal disallowed %s", EventPrefixOther, event.Minute, player, teamMarker)
+
+	case EventTypeStatus:
+		// Kickoff, half-time, full-time... Player holds the phase name
+		phase := ""
+		if event.Player != nil {
+			phase = *event.Player
+		}
+		return fmt.Sprintf("%s %d' [%s]", EventPrefixOther, event.Minute, strings.ToUpper(phase))
+
 	case "addedtime":
 		// Skip added time events - not useful
 		return ""
@@ -201,23 +274,3 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		return fmt.Sprintf("%s %d' %s %s", EventPrefixOther, event.Minute, event.Type, teamMarker)
 	}
 }
-
-// NewEvents compares two event lists and returns only new events.
-// This is useful for detecting new updates when polling match details.
-func (p *LiveUpdateParser) NewEvents(oldEvents, newEvents []api.MatchEvent) []api.MatchEvent {
-	// Create a map of old event IDs for quick lookup
-	oldEventMap := make(map[int]bool)
-	for _, event := range oldEvents {
-		oldEventMap[event.ID] = true
-	}
-
-	// Find events that don't exist in old events
-	var newOnly []api.MatchEvent
-	for _, event := range newEvents {
-		if !oldEventMap[event.ID] {
-			newOnly = append(newOnly, event)
-		}
-	}
-
-	return newOnly
-}
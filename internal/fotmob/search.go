@@ -0,0 +1,74 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+var _ api.TeamSearcher = (*Client)(nil)
+
+// fotmobSearchGroup is a group of /api/search/suggest results (teams,
+// players, leagues, ...).
+type fotmobSearchGroup struct {
+	Suggestions []fotmobSuggestion `json:"suggestions"`
+}
+
+// fotmobSuggestion is a single search result. IDs come as strings or numbers
+// depending on the result type.
+type fotmobSuggestion struct {
+	Type       string      `json:"type"`
+	ID         json.Number `json:"id"`
+	Name       string      `json:"name"`
+	LeagueID   json.Number `json:"leagueId"`
+	LeagueName string      `json:"leagueName"`
+}
+
+// SearchTeams looks teams up by name, each with the league it plays in.
+// Searches aren't cached: they are typed by hand and rarely repeated.
+func (c *Client) SearchTeams(ctx context.Context, query string) ([]api.TeamSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+	requestURL := fmt.Sprintf("%s/search/suggest?term=%s&lang=en", c.baseURL, url.QueryEscape(query))
+
+	resp, err := c.get(ctx, requestURL)
+	if err != nil {
+		return nil, fmt.Errorf("search teams %q: %w", query, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for team search %q", resp.StatusCode, query)
+	}
+
+	var groups []fotmobSearchGroup
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, fmt.Errorf("decode team search response: %w", err)
+	}
+
+	var results []api.TeamSearchResult
+	seen := make(map[int]bool)
+	for _, group := range groups {
+		for _, s := range group.Suggestions {
+			id, err := strconv.Atoi(s.ID.String())
+			if s.Type != "team" || err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+			leagueID, _ := strconv.Atoi(s.LeagueID.String())
+			results = append(results, api.TeamSearchResult{
+				Team:   api.Team{ID: id, Name: s.Name, ShortName: s.Name},
+				League: api.League{ID: leagueID, Name: s.LeagueName},
+			})
+		}
+	}
+	return results, nil
+}
@@ -3,9 +3,10 @@ package fotmob
 import (
 	"context"
 	"fmt"
-	"time"
+	"sync"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // StatsData holds all matches data for the stats view.
@@ -26,54 +27,83 @@ const StatsDataDays = 5
 // FetchStatsData fetches all stats data in one call: 5 days of finished matches + today's upcoming.
 // This is the primary API for the stats view - always fetches 5 days, then filters client-side.
 //
-// OPTIMIZATION: Only queries "fixtures" tab for today (upcoming matches).
-// Past days only need "results" tab (finished matches).
+// Each league's "results" and "fixtures" payloads cover the whole season, so
+// they are fetched once and bucketed by date in memory instead of re-requesting
+// the same payload for every day.
 //
-// API calls breakdown:
-//   - Today: 14 leagues × 2 tabs = 28 requests (need both fixtures + results)
-//   - Past 4 days: 14 leagues × 1 tab × 4 = 56 requests (only results)
-//   - Total: 84 requests
+// API calls breakdown (N active leagues):
+//   - Per-day fetching: N × 2 tabs today + N × 1 tab × 4 past days = 6N requests
+//   - Bucketed: N leagues × 2 tabs = 2N requests, shared with any concurrent caller
 //
 // Benefits:
 // - Single fetch pattern (always 5 days)
 // - Covers mid-week breaks when no matches scheduled
 // - Instant switching between Today/5d views after initial load
+//
+// If only some leagues fail, the data from the others is returned together
+// with a *api.PartialResultError.
 func (c *Client) FetchStatsData(ctx context.Context) (*StatsData, error) {
-	today := time.Now().UTC()
-	todayStr := today.Format("2006-01-02")
-
-	var allFinished []api.Match
-	var todayFinished []api.Match
-	var todayUpcoming []api.Match
-	var lastErr error
-	successCount := 0
+	// Days are calendar days in the configured timezone
+	today := data.Now()
+	todayStr := data.DateKey(today)
 
-	// Fetch 5 days of matches (today + last 4 days)
+	// Dates in the window, for bucketing
+	window := make(map[string]bool, StatsDataDays)
 	for i := 0; i < StatsDataDays; i++ {
-		date := today.AddDate(0, 0, -i)
-		dateStr := date.Format("2006-01-02")
-		isToday := dateStr == todayStr
+		window[data.DateKey(today.AddDate(0, 0, -i))] = true
+	}
 
-		var matches []api.Match
-		var err error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	payloads := make(map[string][]api.Match) // key: tab
+	var failures []*api.LeagueError
+	requests := 0
 
-		if isToday {
-			// Today: need both fixtures (upcoming) and results (finished)
-			matches, err = c.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
-		} else {
-			// Past days: only need results (finished matches)
-			matches, err = c.MatchesByDateWithTabs(ctx, date, []string{"results"})
-		}
+	for _, tab := range []string{"results", "fixtures"} {
+		for _, leagueID := range GetActiveLeagues() {
+			requests++
+			wg.Add(1)
+			go func(id int, tabName string) {
+				defer wg.Done()
+
+				matches, err := c.leagueTabMatches(ctx, id, tabName)
 
-		if err != nil {
-			lastErr = fmt.Errorf("fetch matches for date %s: %w", dateStr, err)
-			continue
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failures = append(failures, asLeagueError(err, id, tabName))
+					return
+				}
+				payloads[tabName] = append(payloads[tabName], matches...)
+			}(leagueID, tab)
 		}
-		successCount++
+	}
+	wg.Wait()
+
+	if len(failures) >= requests && requests > 0 {
+		return nil, fmt.Errorf("failed to fetch matches for any league: %w", &api.PartialResultError{
+			Date:     todayStr,
+			Requests: requests,
+			Failures: failures,
+		})
+	}
+
+	// Bucket by date: finished matches from the whole window, upcoming from today only
+	var allFinished, todayFinished, todayUpcoming []api.Match
+	seen := make(map[int]bool)
+	for _, tab := range []string{"results", "fixtures"} {
+		for _, match := range payloads[tab] {
+			if match.MatchTime == nil || seen[match.ID] {
+				continue
+			}
+			dateStr := data.DateKey(*match.MatchTime)
+			if !window[dateStr] {
+				continue
+			}
+			isToday := dateStr == todayStr
 
-		// Process matches for this day
-		for _, match := range matches {
 			if match.Status == api.MatchStatusFinished {
+				seen[match.ID] = true
 				allFinished = append(allFinished, match)
 				// Also track today's finished separately
 				if isToday {
@@ -81,19 +111,24 @@ func (c *Client) FetchStatsData(ctx context.Context) (*StatsData, error) {
 				}
 			} else if match.Status == api.MatchStatusNotStarted && isToday {
 				// Only today has upcoming matches
+				seen[match.ID] = true
 				todayUpcoming = append(todayUpcoming, match)
 			}
 		}
 	}
 
-	// Return error only if all days failed
-	if successCount == 0 {
-		return nil, fmt.Errorf("failed to fetch matches for any date: %w", lastErr)
-	}
-
-	return &StatsData{
+	data := &StatsData{
 		AllFinished:   allFinished,
 		TodayFinished: todayFinished,
 		TodayUpcoming: todayUpcoming,
-	}, nil
+	}
+
+	if len(failures) > 0 {
+		return data, &api.PartialResultError{
+			Date:     todayStr,
+			Requests: requests,
+			Failures: failures,
+		}
+	}
+	return data, nil
 }
@@ -41,12 +41,18 @@ type status struct {
 	Cancelled *bool     `json:"cancelled"` // Can be null
 	LiveTime  *liveTime `json:"liveTime,omitempty"`
 	Score     *score    `json:"score,omitempty"`
+	Reason    *reason   `json:"reason,omitempty"` // How a finished match ended
 }
 
 type liveTime struct {
 	Short string `json:"short"`
 }
 
+type reason struct {
+	Short     string `json:"short"`               // "FT", "AET", "Pen"
+	Penalties []int  `json:"penalties,omitempty"` // [home, away] after a shootout
+}
+
 type score struct {
 	Home int `json:"home"`
 	Away int `json:"away"`
@@ -308,12 +314,26 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		details.Match.HomeScore = &homeScore
 		details.Match.AwayScore = &awayScore
 
+		// Shootout result, which decides drawn knockout matches. The score
+		// stays the one after extra time; only the winner is decided by it.
+		homeDecider, awayDecider := homeScore, awayScore
+		if reason := m.Header.Status.Reason; reason != nil && len(reason.Penalties) == 2 {
+			homePens, awayPens := reason.Penalties[0], reason.Penalties[1]
+			details.Penalties = &struct {
+				Home *int `json:"home,omitempty"`
+				Away *int `json:"away,omitempty"`
+			}{Home: &homePens, Away: &awayPens}
+			if homeScore == awayScore {
+				homeDecider, awayDecider = homePens, awayPens
+			}
+		}
+
 		// Determine winner for finished matches
 		if status == api.MatchStatusFinished {
-			if homeScore > awayScore {
+			if homeDecider > awayDecider {
 				winner := "home"
 				details.Winner = &winner
-			} else if awayScore > homeScore {
+			} else if awayDecider > homeDecider {
 				winner := "away"
 				details.Winner = &winner
 			}
@@ -418,6 +438,8 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		eventTypeDetail := ""
 		if e.Type == "Card" && e.Card != "" {
 			eventTypeDetail = strings.ToLower(e.Card)
+		} else if e.Type == "Goal" && e.IsPenalty != nil && *e.IsPenalty {
+			eventTypeDetail = "penalty"
 		} else if e.Type == "Substitution" && len(e.Swap) >= 2 {
 			// Substitution: swap[0] is player coming IN, swap[1] is player going OUT
 			// Store player out in Player field, player in in Assist field (repurposed)
@@ -585,26 +607,69 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 	}
 }
 
+// fotmobLeagueTableResponse represents the table section of the /leagues response.
+// FotMob wraps standings in a "table" array; single-table leagues use data.table,
+// while competitions with several tables (UCL league phase, World Cup groups)
+// set data.composite and list each table under data.tables.
+type fotmobLeagueTableResponse struct {
+	Table []struct {
+		Data fotmobTableData `json:"data"`
+	} `json:"table"`
+}
+
+// fotmobTableData holds one standings block from FotMob.
+type fotmobTableData struct {
+	LeagueID   int                 `json:"leagueId"`
+	LeagueName string              `json:"leagueName"`
+	Composite  bool                `json:"composite"`
+	Legend     []fotmobTableLegend `json:"legend"`
+	Table      fotmobTableSet      `json:"table"`
+	Tables     []fotmobSubTable    `json:"tables"`
+}
+
+// fotmobSubTable represents a single group/table inside a composite competition.
+type fotmobSubTable struct {
+	LeagueName string              `json:"leagueName"`
+	Legend     []fotmobTableLegend `json:"legend"`
+	Table      fotmobTableSet      `json:"table"`
+}
+
+// fotmobTableSet contains the overall/home/away variants of a table.
+// Only the overall ("all") table is used.
+type fotmobTableSet struct {
+	All []fotmobTableRow `json:"all"`
+}
+
+// fotmobTableLegend describes a qualification/relegation zone.
+// Indices are 0-based table positions covered by the zone.
+type fotmobTableLegend struct {
+	Title   string `json:"title"`
+	Color   string `json:"color"`
+	Indices []int  `json:"indices"`
+}
+
 // fotmobTableRow represents a single row in the league table from FotMob
 type fotmobTableRow struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	ShortName      string `json:"shortName"`
-	Rank           int    `json:"rank"`
-	Played         int    `json:"played"`
-	Wins           int    `json:"wins"`
-	Draws          int    `json:"draws"`
-	Losses         int    `json:"losses"`
-	GoalsFor       int    `json:"goalsFor"`
-	GoalsAgainst   int    `json:"goalsAgainst"`
-	GoalDifference int    `json:"goalDifference"`
-	Points         int    `json:"points"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ShortName   string `json:"shortName"`
+	Idx         int    `json:"idx"`
+	Played      int    `json:"played"`
+	Wins        int    `json:"wins"`
+	Draws       int    `json:"draws"`
+	Losses      int    `json:"losses"`
+	ScoresStr   string `json:"scoresStr"` // "goalsFor-goalsAgainst", e.g. "14-3"
+	GoalConDiff int    `json:"goalConDiff"`
+	Pts         int    `json:"pts"`
+	QualColor   string `json:"qualColor,omitempty"`
 }
 
 // toAPITableEntry converts fotmobTableRow to api.LeagueTableEntry
 func (r fotmobTableRow) toAPITableEntry() api.LeagueTableEntry {
+	goalsFor, goalsAgainst := parseScoresStr(r.ScoresStr)
+
 	return api.LeagueTableEntry{
-		Position: r.Rank,
+		Position: r.Idx,
 		Team: api.Team{
 			ID:        r.ID,
 			Name:      r.Name,
@@ -614,11 +679,75 @@ func (r fotmobTableRow) toAPITableEntry() api.LeagueTableEntry {
 		Won:            r.Wins,
 		Drawn:          r.Draws,
 		Lost:           r.Losses,
-		GoalsFor:       r.GoalsFor,
-		GoalsAgainst:   r.GoalsAgainst,
-		GoalDifference: r.GoalDifference,
-		Points:         r.Points,
+		GoalsFor:       goalsFor,
+		GoalsAgainst:   goalsAgainst,
+		GoalDifference: r.GoalConDiff,
+		Points:         r.Pts,
+		ZoneColor:      r.QualColor,
+	}
+}
+
+// toAPITableEntries flattens all tables in the response into a single slice.
+// For composite competitions each entry is labelled with its group name.
+func (r fotmobLeagueTableResponse) toAPITableEntries() []api.LeagueTableEntry {
+	var entries []api.LeagueTableEntry
+
+	for _, t := range r.Table {
+		data := t.Data
+
+		if len(data.Tables) > 0 {
+			for _, sub := range data.Tables {
+				entries = append(entries, convertTableRows(sub.Table.All, sub.Legend, sub.LeagueName)...)
+			}
+			continue
+		}
+
+		// Single-table leagues don't need a group label
+		group := ""
+		if data.Composite || len(r.Table) > 1 {
+			group = data.LeagueName
+		}
+		entries = append(entries, convertTableRows(data.Table.All, data.Legend, group)...)
+	}
+
+	return entries
+}
+
+// convertTableRows converts table rows and tags them with zone info from the legend.
+func convertTableRows(rows []fotmobTableRow, legend []fotmobTableLegend, group string) []api.LeagueTableEntry {
+	// Map 0-based table index -> legend entry
+	zones := make(map[int]fotmobTableLegend)
+	for _, l := range legend {
+		for _, idx := range l.Indices {
+			zones[idx] = l
+		}
+	}
+
+	entries := make([]api.LeagueTableEntry, 0, len(rows))
+	for i, row := range rows {
+		entry := row.toAPITableEntry()
+		entry.Group = group
+		if entry.Position == 0 {
+			entry.Position = i + 1
+		}
+		if zone, ok := zones[i]; ok {
+			entry.Zone = zone.Title
+			if entry.ZoneColor == "" {
+				entry.ZoneColor = zone.Color
+			}
+		}
+		entries = append(entries, entry)
 	}
+	return entries
+}
+
+// parseScoresStr parses FotMob's "GF-GA" string (e.g., "14-3") into goals for/against.
+func parseScoresStr(s string) (int, int) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0
+	}
+	return parseInt(strings.TrimSpace(parts[0])), parseInt(strings.TrimSpace(parts[1]))
 }
 
 // Helper function to parse time from various formats
@@ -649,3 +778,119 @@ func parseInt(s string) int {
 	}
 	return val
 }
+
+// fotmobAllLeaguesResponse represents the response from /api/allLeagues.
+// Leagues are listed once under "popular" and again grouped by country;
+// international competitions have their own section.
+type fotmobAllLeaguesResponse struct {
+	Popular       []fotmobLeagueItem     `json:"popular"`
+	International []fotmobCountryLeagues `json:"international"`
+	Countries     []fotmobCountryLeagues `json:"countries"`
+}
+
+// fotmobCountryLeagues is a country (or international grouping) with its leagues.
+type fotmobCountryLeagues struct {
+	CCode   string             `json:"ccode"`
+	Name    string             `json:"name"`
+	Leagues []fotmobLeagueItem `json:"leagues"`
+}
+
+// fotmobLeagueItem is a single league entry in the all-leagues listing.
+type fotmobLeagueItem struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	LocalizedName string `json:"localizedName"`
+}
+
+// toAPILeagues flattens the listing into api.League values, deduplicated by ID.
+// International competitions come first, followed by countries in listing order.
+func (r fotmobAllLeaguesResponse) toAPILeagues() []api.League {
+	seen := make(map[int]bool)
+	var leagues []api.League
+
+	groups := make([]fotmobCountryLeagues, 0, len(r.International)+len(r.Countries))
+	groups = append(groups, r.International...)
+	groups = append(groups, r.Countries...)
+
+	for _, group := range groups {
+		for _, item := range group.Leagues {
+			if item.ID == 0 || seen[item.ID] {
+				continue
+			}
+			seen[item.ID] = true
+
+			name := item.Name
+			if name == "" {
+				name = item.LocalizedName
+			}
+			leagues = append(leagues, api.League{
+				ID:          item.ID,
+				Name:        name,
+				Country:     group.Name,
+				CountryCode: group.CCode,
+			})
+		}
+	}
+
+	return leagues
+}
+
+// fotmobLeagueSeasonResponse represents the parts of /api/leagues used for season fixtures.
+type fotmobLeagueSeasonResponse struct {
+	Details struct {
+		ID             int    `json:"id"`
+		Name           string `json:"name"`
+		Country        string `json:"country"`
+		CountryCode    string `json:"countryCode,omitempty"`
+		SelectedSeason string `json:"selectedSeason"`
+	} `json:"details"`
+	AllAvailableSeasons []string `json:"allAvailableSeasons"`
+	Fixtures            struct {
+		AllMatches []fotmobMatch `json:"allMatches"`
+	} `json:"fixtures"`
+}
+
+// toAPILeagueSeason converts the response to an api.LeagueSeason.
+// Matches missing league info inherit it from the response details.
+// requestedSeason is used when the response doesn't name the selected season.
+func (r fotmobLeagueSeasonResponse) toAPILeagueSeason(requestedSeason string) *api.LeagueSeason {
+	leagueInfo := league{
+		ID:          r.Details.ID,
+		Name:        r.Details.Name,
+		Country:     r.Details.Country,
+		CountryCode: r.Details.CountryCode,
+	}
+
+	season := &api.LeagueSeason{
+		League: api.League{
+			ID:          leagueInfo.ID,
+			Name:        leagueInfo.Name,
+			Country:     leagueInfo.Country,
+			CountryCode: leagueInfo.CountryCode,
+		},
+		Season:  r.Details.SelectedSeason,
+		Seasons: r.AllAvailableSeasons,
+		Matches: make([]api.Match, 0, len(r.Fixtures.AllMatches)),
+	}
+	if season.Season == "" {
+		season.Season = requestedSeason
+	}
+
+	for _, m := range r.Fixtures.AllMatches {
+		if m.League.ID == 0 {
+			m.League = leagueInfo
+		}
+		season.Matches = append(season.Matches, m.toAPIMatch())
+	}
+
+	// Order by kickoff; matches without a time go last
+	sort.SliceStable(season.Matches, func(i, j int) bool {
+		ti, tj := season.Matches[i].MatchTime, season.Matches[j].MatchTime
+		if ti == nil || tj == nil {
+			return ti != nil
+		}
+		return ti.Before(*tj)
+	})
+
+	return season
+}
@@ -0,0 +1,208 @@
+package fotmobfake
+
+import (
+	"fmt"
+	"time"
+)
+
+// Side selects the home or away team.
+type Side int
+
+const (
+	Home Side = iota
+	Away
+)
+
+// Card colours, as FotMob names them.
+const (
+	CardYellow = "Yellow"
+	CardRed    = "Red"
+)
+
+// event is a scripted match event.
+type event struct {
+	id       int
+	minute   int
+	kind     string // "Goal", "Card", "Substitution", "Half"
+	side     Side
+	player   string
+	assist   string // Goals only
+	card     string // Cards only
+	playerIn string // Substitutions only; player is the one going off
+	score    [2]int // Score after the event
+}
+
+// lineup is a team's announced line-up.
+type lineup struct {
+	formation string
+	starting  []string
+	bench     []string
+}
+
+// Match is a scripted match. Its methods change what the server returns from
+// the next request on, and return the match so steps can be chained:
+//
+//	match.Kickoff().At(12).Goal(fotmobfake.Home, "Saka", "").HalfTime()
+type Match struct {
+	server *Server
+
+	id       int
+	leagueID int
+	round    string
+	home     Team
+	away     Team
+	kickoff  time.Time
+
+	started   bool
+	finished  bool
+	cancelled bool
+	clock     string // FotMob's liveTime.short: "23'", "HT", "FT", "Pen"
+	minute    int
+	score     [2]int
+	penalties *[2]int
+	events    []event
+	lineups   *[2]lineup
+}
+
+// ID returns the match ID.
+func (m *Match) ID() int {
+	return m.id
+}
+
+// Round sets the round name (default "1").
+func (m *Match) Round(round string) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.round = round
+	return m
+}
+
+// Kickoff starts the match at minute 1.
+func (m *Match) Kickoff() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.started = true
+	m.setMinute(1)
+	return m
+}
+
+// At moves the match clock to minute (e.g., 67, or 105 in extra time).
+func (m *Match) At(minute int) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.setMinute(minute)
+	return m
+}
+
+// setMinute sets the clock. Caller holds the server lock.
+func (m *Match) setMinute(minute int) {
+	m.minute = minute
+	m.clock = fmt.Sprintf("%d'", minute)
+}
+
+// Goal scores a goal for side at the current minute. assist may be empty.
+func (m *Match) Goal(side Side, scorer, assist string) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.score[side]++
+	m.addEvent(event{kind: "Goal", side: side, player: scorer, assist: assist})
+	return m
+}
+
+// Card shows a card (CardYellow or CardRed) to a player of side.
+func (m *Match) Card(side Side, player, card string) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.addEvent(event{kind: "Card", side: side, player: player, card: card})
+	return m
+}
+
+// Substitution brings playerIn on for playerOut.
+func (m *Match) Substitution(side Side, playerIn, playerOut string) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.addEvent(event{kind: "Substitution", side: side, player: playerOut, playerIn: playerIn})
+	return m
+}
+
+// HalfTime pauses the match at 45' and records the half-time score.
+func (m *Match) HalfTime() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.minute = 45
+	m.clock = "HT"
+	m.addEvent(event{kind: "Half"})
+	return m
+}
+
+// SecondHalf restarts the match at 46'.
+func (m *Match) SecondHalf() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.setMinute(46)
+	return m
+}
+
+// FullTime ends the match.
+func (m *Match) FullTime() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.started = true
+	m.finished = true
+	m.clock = "FT"
+	return m
+}
+
+// PenaltyShootout moves a drawn match to a shootout (still live).
+func (m *Match) PenaltyShootout() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.clock = "Pen"
+	return m
+}
+
+// Penalties ends the match with a shootout result.
+func (m *Match) Penalties(home, away int) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.started = true
+	m.finished = true
+	m.clock = "Pen"
+	m.penalties = &[2]int{home, away}
+	return m
+}
+
+// Cancel cancels the match.
+func (m *Match) Cancel() *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.cancelled = true
+	return m
+}
+
+// Lineups announces both line-ups (starting players, then the bench).
+func (m *Match) Lineups(homeFormation string, home, homeBench []string, awayFormation string, away, awayBench []string) *Match {
+	m.server.mu.Lock()
+	defer m.server.mu.Unlock()
+	m.lineups = &[2]lineup{
+		{formation: homeFormation, starting: home, bench: homeBench},
+		{formation: awayFormation, starting: away, bench: awayBench},
+	}
+	return m
+}
+
+// addEvent records an event at the current minute. Caller holds the server lock.
+func (m *Match) addEvent(e event) {
+	e.id = m.id*100 + len(m.events) + 1
+	e.minute = m.minute
+	e.score = m.score
+	m.events = append(m.events, e)
+}
+
+// team returns the team playing on side.
+func (m *Match) team(side Side) Team {
+	if side == Home {
+		return m.home
+	}
+	return m.away
+}
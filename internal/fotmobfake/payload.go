@@ -0,0 +1,378 @@
+package fotmobfake
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// currentSeason is the season leagues have when none are given.
+const currentSeason = "2025/2026"
+
+// utcLayout is how FotMob formats kickoff times.
+const utcLayout = "2006-01-02T15:04:05.000Z"
+
+// The types below mirror the parts of FotMob's responses fotmob.Client
+// decodes. They are kept separate from the client's types on purpose, so
+// tests catch decoding changes instead of sharing them.
+
+type leaguePayload struct {
+	Details             leagueDetailsPayload `json:"details"`
+	AllAvailableSeasons []string             `json:"allAvailableSeasons"`
+	Fixtures            fixturesPayload      `json:"fixtures"`
+	Table               []tablePayload       `json:"table"`
+}
+
+type leagueDetailsPayload struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Country        string `json:"country"`
+	CountryCode    string `json:"countryCode"`
+	SelectedSeason string `json:"selectedSeason"`
+}
+
+type fixturesPayload struct {
+	AllMatches []leagueMatchPayload `json:"allMatches"`
+}
+
+type leagueMatchPayload struct {
+	ID     string        `json:"id"`
+	Round  string        `json:"round"`
+	Home   teamPayload   `json:"home"`
+	Away   teamPayload   `json:"away"`
+	Status statusPayload `json:"status"`
+}
+
+type teamPayload struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ShortName string `json:"shortName"`
+}
+
+type statusPayload struct {
+	UTCTime   string           `json:"utcTime"`
+	Started   bool             `json:"started"`
+	Finished  bool             `json:"finished"`
+	Cancelled bool             `json:"cancelled"`
+	LiveTime  *liveTimePayload `json:"liveTime,omitempty"`
+	Score     *scorePayload    `json:"score,omitempty"`
+	Reason    *reasonPayload   `json:"reason,omitempty"`
+}
+
+type liveTimePayload struct {
+	Short string `json:"short"`
+}
+
+type scorePayload struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+type reasonPayload struct {
+	Short     string `json:"short"`
+	Penalties []int  `json:"penalties,omitempty"`
+}
+
+type tablePayload struct {
+	Data tableDataPayload `json:"data"`
+}
+
+type tableDataPayload struct {
+	LeagueID   int             `json:"leagueId"`
+	LeagueName string          `json:"leagueName"`
+	Table      tableSetPayload `json:"table"`
+}
+
+type tableSetPayload struct {
+	All []tableRowPayload `json:"all"`
+}
+
+type tableRowPayload struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ShortName   string `json:"shortName"`
+	Idx         int    `json:"idx"`
+	Played      int    `json:"played"`
+	Wins        int    `json:"wins"`
+	Draws       int    `json:"draws"`
+	Losses      int    `json:"losses"`
+	ScoresStr   string `json:"scoresStr"`
+	GoalConDiff int    `json:"goalConDiff"`
+	Pts         int    `json:"pts"`
+
+	goalsFor int // Tie-breaker while sorting
+}
+
+type detailsPayload struct {
+	Header struct {
+		Teams  []detailsTeamPayload `json:"teams"`
+		Status statusPayload        `json:"status"`
+	} `json:"header"`
+	General struct {
+		MatchID    string        `json:"matchId"`
+		MatchRound string        `json:"matchRound"`
+		HomeTeam   idNamePayload `json:"homeTeam"`
+		AwayTeam   idNamePayload `json:"awayTeam"`
+		LeagueID   int           `json:"leagueId"`
+		LeagueName string        `json:"leagueName"`
+	} `json:"general"`
+	Content struct {
+		MatchFacts struct {
+			Events struct {
+				Events []eventPayload `json:"events"`
+			} `json:"events"`
+		} `json:"matchFacts"`
+		Lineup struct {
+			Lineup []lineupPayload `json:"lineup"`
+		} `json:"lineup"`
+	} `json:"content"`
+}
+
+type detailsTeamPayload struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+type idNamePayload struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type eventPayload struct {
+	Time        int            `json:"time"`
+	TimeStr     string         `json:"timeStr"`
+	Type        string         `json:"type"`
+	EventID     int            `json:"eventId"`
+	IsHome      bool           `json:"isHome"`
+	Player      *idNamePayload `json:"player,omitempty"`
+	NameStr     string         `json:"nameStr,omitempty"`
+	HomeScore   int            `json:"homeScore"`
+	AwayScore   int            `json:"awayScore"`
+	NewScore    []int          `json:"newScore,omitempty"`
+	Card        string         `json:"card,omitempty"`
+	Swap        []swapPayload  `json:"swap,omitempty"`
+	AssistInput string         `json:"assistInput,omitempty"`
+}
+
+type swapPayload struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+type lineupPayload struct {
+	TeamID    int               `json:"teamId"`
+	TeamName  string            `json:"teamName"`
+	Formation string            `json:"formation"`
+	Players   [][]playerPayload `json:"players"`
+	Bench     []playerPayload   `json:"bench"`
+}
+
+type playerPayload struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Shirt int    `json:"shirt"`
+}
+
+type allLeaguesPayload struct {
+	Popular       []leagueItemPayload      `json:"popular"`
+	International []*countryLeaguesPayload `json:"international"`
+	Countries     []*countryLeaguesPayload `json:"countries"`
+}
+
+type countryLeaguesPayload struct {
+	CCode   string              `json:"ccode"`
+	Name    string              `json:"name"`
+	Leagues []leagueItemPayload `json:"leagues"`
+}
+
+type leagueItemPayload struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// /api/search/suggest: FotMob sends team IDs as strings.
+type searchGroupPayload struct {
+	Suggestions []suggestionPayload `json:"suggestions"`
+}
+
+type suggestionPayload struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	LeagueID   int    `json:"leagueId"`
+	LeagueName string `json:"leagueName"`
+}
+
+// status returns the match status block. Caller holds the server lock.
+func (m *Match) status() statusPayload {
+	status := statusPayload{
+		UTCTime:   m.kickoff.Format(utcLayout),
+		Started:   m.started,
+		Finished:  m.finished,
+		Cancelled: m.cancelled,
+	}
+	if m.started {
+		status.Score = &scorePayload{Home: m.score[Home], Away: m.score[Away]}
+		if !m.finished {
+			status.LiveTime = &liveTimePayload{Short: m.clock}
+		}
+	}
+	if m.finished {
+		status.Reason = &reasonPayload{Short: "FT"}
+		if m.penalties != nil {
+			status.Reason = &reasonPayload{Short: "Pen", Penalties: []int{m.penalties[Home], m.penalties[Away]}}
+		}
+	}
+	return status
+}
+
+// leaguePayload returns the match as listed in /api/leagues. Caller holds the server lock.
+func (m *Match) leaguePayload() leagueMatchPayload {
+	return leagueMatchPayload{
+		ID:     strconv.Itoa(m.id),
+		Round:  m.round,
+		Home:   teamPayload{ID: strconv.Itoa(m.home.ID), Name: m.home.Name, ShortName: m.home.ShortName},
+		Away:   teamPayload{ID: strconv.Itoa(m.away.ID), Name: m.away.Name, ShortName: m.away.ShortName},
+		Status: m.status(),
+	}
+}
+
+// detailsPayload returns the /api/matchDetails response. Caller holds the server lock.
+func (m *Match) detailsPayload(league *League) detailsPayload {
+	var p detailsPayload
+	p.Header.Teams = []detailsTeamPayload{
+		{ID: m.home.ID, Name: m.home.Name, Score: m.score[Home]},
+		{ID: m.away.ID, Name: m.away.Name, Score: m.score[Away]},
+	}
+	p.Header.Status = m.status()
+	p.General.MatchID = strconv.Itoa(m.id)
+	p.General.MatchRound = m.round
+	p.General.HomeTeam = idNamePayload{ID: m.home.ID, Name: m.home.Name}
+	p.General.AwayTeam = idNamePayload{ID: m.away.ID, Name: m.away.Name}
+	p.General.LeagueID = m.leagueID
+	if league != nil {
+		p.General.LeagueName = league.Name
+	}
+
+	events := make([]eventPayload, 0, len(m.events))
+	for _, e := range m.events {
+		payload := eventPayload{
+			Time:      e.minute,
+			TimeStr:   strconv.Itoa(e.minute),
+			Type:      e.kind,
+			EventID:   e.id,
+			IsHome:    e.side == Home,
+			HomeScore: e.score[Home],
+			AwayScore: e.score[Away],
+		}
+		switch e.kind {
+		case "Goal":
+			payload.Player = &idNamePayload{ID: playerID(e.player), Name: e.player}
+			payload.NewScore = []int{e.score[Home], e.score[Away]}
+			payload.AssistInput = e.assist
+		case "Card":
+			payload.Player = &idNamePayload{ID: playerID(e.player), Name: e.player}
+			payload.Card = e.card
+		case "Substitution":
+			// FotMob lists the player coming on first
+			payload.Swap = []swapPayload{
+				{Name: e.playerIn, ID: strconv.Itoa(playerID(e.playerIn))},
+				{Name: e.player, ID: strconv.Itoa(playerID(e.player))},
+			}
+		case "Half":
+			payload.TimeStr = "HT"
+		}
+		events = append(events, payload)
+	}
+	p.Content.MatchFacts.Events.Events = events
+
+	if m.lineups != nil {
+		for side, l := range m.lineups {
+			team := m.team(Side(side))
+			payload := lineupPayload{TeamID: team.ID, TeamName: team.Name, Formation: l.formation}
+			row := make([]playerPayload, 0, len(l.starting))
+			for i, name := range l.starting {
+				row = append(row, playerPayload{ID: playerID(name), Name: name, Shirt: i + 1})
+			}
+			payload.Players = [][]playerPayload{row}
+			for i, name := range l.bench {
+				payload.Bench = append(payload.Bench, playerPayload{ID: playerID(name), Name: name, Shirt: len(l.starting) + i + 1})
+			}
+			p.Content.Lineup.Lineup = append(p.Content.Lineup.Lineup, payload)
+		}
+	}
+
+	return p
+}
+
+// standings builds a league table from finished matches: 3 points a win,
+// ordered by points, goal difference, then goals scored.
+func standings(league *League, played []*Match) tableDataPayload {
+	rows := make(map[int]*tableRowPayload)
+	row := func(team Team) *tableRowPayload {
+		r, ok := rows[team.ID]
+		if !ok {
+			r = &tableRowPayload{ID: team.ID, Name: team.Name, ShortName: team.ShortName}
+			rows[team.ID] = r
+		}
+		return r
+	}
+
+	for _, match := range played {
+		home, away := row(match.home), row(match.away)
+		record(home, match.score[Home], match.score[Away])
+		record(away, match.score[Away], match.score[Home])
+	}
+
+	table := make([]tableRowPayload, 0, len(rows))
+	for _, r := range rows {
+		table = append(table, *r)
+	}
+	sort.Slice(table, func(i, j int) bool {
+		a, b := table[i], table[j]
+		if a.Pts != b.Pts {
+			return a.Pts > b.Pts
+		}
+		if a.GoalConDiff != b.GoalConDiff {
+			return a.GoalConDiff > b.GoalConDiff
+		}
+		if a.goalsFor != b.goalsFor {
+			return a.goalsFor > b.goalsFor
+		}
+		return a.Name < b.Name
+	})
+	for i := range table {
+		table[i].Idx = i + 1
+	}
+
+	return tableDataPayload{LeagueID: league.ID, LeagueName: league.Name, Table: tableSetPayload{All: table}}
+}
+
+// record adds one result to a team's row.
+func record(r *tableRowPayload, scored, conceded int) {
+	r.Played++
+	switch {
+	case scored > conceded:
+		r.Wins++
+		r.Pts += 3
+	case scored == conceded:
+		r.Draws++
+		r.Pts++
+	default:
+		r.Losses++
+	}
+	r.goalsFor += scored
+	r.GoalConDiff += scored - conceded
+	goalsAgainst := r.goalsFor - r.GoalConDiff
+	r.ScoresStr = fmt.Sprintf("%d-%d", r.goalsFor, goalsAgainst)
+}
+
+// playerID derives a stable ID from a player's name.
+func playerID(name string) int {
+	id := 0
+	for _, r := range name {
+		id = (id*31 + int(r)) % 1000000
+	}
+	return id
+}
@@ -0,0 +1,352 @@
+// Package fotmobfake is an in-process fake of the FotMob API for tests.
+//
+// The server answers /api/leagues, /api/matchDetails, /api/allLeagues and
+// /api/search/suggest with
+// the JSON shapes fotmob.Client decodes. Tests add leagues and matches, then
+// script matches through kickoff, goals, cards, half time, full time and
+// penalties; every request sees the current state. Latency, 429s, 5xx
+// responses and malformed JSON can be injected per endpoint.
+//
+//	fake := fotmobfake.New()
+//	defer fake.Close()
+//	fake.AddLeague(fotmobfake.League{ID: 47, Name: "Premier League"})
+//	match := fake.AddMatch(47, 1001, arsenal, chelsea, kickoff)
+//	client := fotmob.NewClientWithTransport(fake.Transport())
+//	match.Kickoff().At(23).Goal(fotmobfake.Home, "Saka", "Ødegaard")
+package fotmobfake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoints served by the fake, for Inject and Requests.
+const (
+	EndpointLeagues      = "/api/leagues"
+	EndpointMatchDetails = "/api/matchDetails"
+	EndpointAllLeagues   = "/api/allLeagues"
+	EndpointSearch       = "/api/search/suggest"
+	EndpointAny          = "" // Matches every endpoint
+)
+
+// Fault is a failure the server can answer with instead of data.
+type Fault int
+
+const (
+	FaultRateLimit     Fault = iota // 429 with Retry-After: 0
+	FaultServerError                // 500
+	FaultMalformedJSON              // 200 with a truncated JSON body
+)
+
+// injection is a queued fault for an endpoint.
+type injection struct {
+	endpoint string
+	fault    Fault
+	left     int
+}
+
+// Team is a team in the fake's data.
+type Team struct {
+	ID        int
+	Name      string
+	ShortName string
+}
+
+// League is a league in the fake's data.
+type League struct {
+	ID          int
+	Name        string
+	Country     string
+	CountryCode string
+	Seasons     []string // Newest first; defaults to the current season only
+}
+
+// Server is a fake FotMob API backed by httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	leagues    map[int]*League
+	matches    map[int]*Match
+	order      []int // Match IDs in the order they were added
+	latency    time.Duration
+	injections []*injection
+	requests   map[string]int
+}
+
+// New starts a fake server with no data. Call Close when done.
+func New() *Server {
+	s := &Server{
+		leagues:  make(map[int]*League),
+		matches:  make(map[int]*Match),
+		requests: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(EndpointLeagues, s.handle(s.serveLeague))
+	mux.HandleFunc(EndpointMatchDetails, s.handle(s.serveMatchDetails))
+	mux.HandleFunc(EndpointAllLeagues, s.handle(s.serveAllLeagues))
+	mux.HandleFunc(EndpointSearch, s.handle(s.serveSearch))
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Transport returns a RoundTripper that sends every request to the fake,
+// whatever its host, so a client built for www.fotmob.com talks to it.
+func (s *Server) Transport() http.RoundTripper {
+	return &redirectTransport{target: s.Server, next: s.Server.Client().Transport}
+}
+
+// redirectTransport rewrites requests to point at the fake server.
+type redirectTransport struct {
+	target *httptest.Server
+	next   http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := req.URL.Parse(t.target.URL)
+	if err != nil {
+		return nil, err
+	}
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = target.Scheme
+	redirected.URL.Host = target.Host
+	redirected.Host = target.Host
+	return t.next.RoundTrip(redirected)
+}
+
+// SetLatency delays every response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Inject makes the next times requests to endpoint (EndpointAny for all)
+// fail with fault. Injections are used in the order they were added.
+func (s *Server) Inject(endpoint string, fault Fault, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.injections = append(s.injections, &injection{endpoint: endpoint, fault: fault, left: times})
+}
+
+// Requests returns how many requests endpoint received (EndpointAny for all),
+// including failed ones.
+func (s *Server) Requests(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if endpoint == EndpointAny {
+		total := 0
+		for _, n := range s.requests {
+			total += n
+		}
+		return total
+	}
+	return s.requests[endpoint]
+}
+
+// AddLeague adds a league (or replaces one with the same ID).
+func (s *Server) AddLeague(league League) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(league.Seasons) == 0 {
+		league.Seasons = []string{currentSeason}
+	}
+	s.leagues[league.ID] = &league
+}
+
+// AddMatch adds a not-started match to a league and returns it for scripting.
+func (s *Server) AddMatch(leagueID, matchID int, home, away Team, kickoff time.Time) *Match {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	match := &Match{
+		server:   s,
+		id:       matchID,
+		leagueID: leagueID,
+		round:    "1",
+		home:     home,
+		away:     away,
+		kickoff:  kickoff.UTC(),
+	}
+	if _, exists := s.matches[matchID]; !exists {
+		s.order = append(s.order, matchID)
+	}
+	s.matches[matchID] = match
+	return match
+}
+
+// Match returns a previously added match, or nil.
+func (s *Server) Match(matchID int) *Match {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.matches[matchID]
+}
+
+// handle wraps an endpoint with request counting, latency and fault injection.
+func (s *Server) handle(serve func(r *http.Request) (int, interface{})) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		latency := s.latency
+		fault, faulted := s.takeFault(r.URL.Path)
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if faulted {
+			switch fault {
+			case FaultRateLimit:
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+			case FaultServerError:
+				w.WriteHeader(http.StatusInternalServerError)
+			case FaultMalformedJSON:
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"details": {"id": 47, "name": "Premier Lea`))
+			}
+			return
+		}
+
+		s.mu.Lock()
+		status, body := serve(r)
+		encoded, err := json.Marshal(body)
+		s.mu.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(encoded)
+	}
+}
+
+// takeFault consumes the first pending injection for path. Caller holds s.mu.
+func (s *Server) takeFault(path string) (Fault, bool) {
+	for i, inj := range s.injections {
+		if inj.endpoint != EndpointAny && inj.endpoint != path {
+			continue
+		}
+		inj.left--
+		if inj.left <= 0 {
+			s.injections = append(s.injections[:i], s.injections[i+1:]...)
+		}
+		return inj.fault, true
+	}
+	return 0, false
+}
+
+// queryInt reads an integer query parameter (0 if missing or invalid).
+func queryInt(r *http.Request, name string) int {
+	value, _ := strconv.Atoi(r.URL.Query().Get(name))
+	return value
+}
+
+// notFound is the body served for unknown leagues and matches.
+var notFound = map[string]string{"error": "not found"}
+
+// serveLeague answers /api/leagues?id=N[&tab=fixtures|results][&season=S]:
+// league details, the season's matches and the table. Caller holds s.mu.
+func (s *Server) serveLeague(r *http.Request) (int, interface{}) {
+	league, ok := s.leagues[queryInt(r, "id")]
+	if !ok {
+		return http.StatusNotFound, notFound
+	}
+
+	season := r.URL.Query().Get("season")
+	if season == "" {
+		season = league.Seasons[0]
+	}
+
+	// FotMob's allMatches holds the whole season whichever tab is asked for
+	matches := make([]leagueMatchPayload, 0)
+	var played []*Match
+	if season == league.Seasons[0] {
+		for _, id := range s.order {
+			match := s.matches[id]
+			if match.leagueID != league.ID {
+				continue
+			}
+			matches = append(matches, match.leaguePayload())
+			if match.finished && !match.cancelled {
+				played = append(played, match)
+			}
+		}
+	}
+
+	return http.StatusOK, leaguePayload{
+		Details: leagueDetailsPayload{
+			ID:             league.ID,
+			Name:           league.Name,
+			Country:        league.Country,
+			CountryCode:    league.CountryCode,
+			SelectedSeason: season,
+		},
+		AllAvailableSeasons: league.Seasons,
+		Fixtures:            fixturesPayload{AllMatches: matches},
+		Table:               []tablePayload{{Data: standings(league, played)}},
+	}
+}
+
+// serveMatchDetails answers /api/matchDetails?matchId=N. Caller holds s.mu.
+func (s *Server) serveMatchDetails(r *http.Request) (int, interface{}) {
+	match, ok := s.matches[queryInt(r, "matchId")]
+	if !ok {
+		return http.StatusNotFound, notFound
+	}
+	league := s.leagues[match.leagueID]
+	return http.StatusOK, match.detailsPayload(league)
+}
+
+// serveAllLeagues answers /api/allLeagues with every league under its country.
+// Caller holds s.mu.
+func (s *Server) serveAllLeagues(r *http.Request) (int, interface{}) {
+	byCountry := make(map[string]*countryLeaguesPayload)
+	var countries []*countryLeaguesPayload
+	for _, league := range s.leagues {
+		country, ok := byCountry[league.CountryCode]
+		if !ok {
+			country = &countryLeaguesPayload{CCode: league.CountryCode, Name: league.Country}
+			byCountry[league.CountryCode] = country
+			countries = append(countries, country)
+		}
+		country.Leagues = append(country.Leagues, leagueItemPayload{ID: league.ID, Name: league.Name})
+	}
+	return http.StatusOK, allLeaguesPayload{Countries: countries}
+}
+
+// serveSearch answers /api/search/suggest?term=T with the teams of every
+// match whose name contains the term (case-insensitive), in the league they
+// were first added to. Caller holds s.mu.
+func (s *Server) serveSearch(r *http.Request) (int, interface{}) {
+	term := strings.ToLower(r.URL.Query().Get("term"))
+	suggestions := make([]suggestionPayload, 0)
+	seen := make(map[int]bool)
+	for _, id := range s.order {
+		match := s.matches[id]
+		for _, team := range []Team{match.home, match.away} {
+			if seen[team.ID] || term == "" || !strings.Contains(strings.ToLower(team.Name), term) {
+				continue
+			}
+			seen[team.ID] = true
+			suggestion := suggestionPayload{Type: "team", ID: strconv.Itoa(team.ID), Name: team.Name, LeagueID: match.leagueID}
+			if league, ok := s.leagues[match.leagueID]; ok {
+				suggestion.LeagueName = league.Name
+			}
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	return http.StatusOK, []searchGroupPayload{{Suggestions: suggestions}}
+}
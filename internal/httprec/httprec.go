@@ -0,0 +1,230 @@
+// Package httprec records HTTP exchanges to a directory and replays them,
+// so a session (e.g., a Saturday of live matches) can be reproduced offline.
+//
+// Each exchange is stored as its own numbered JSON file, so a recording that
+// is interrupted keeps everything captured up to that point.
+package httprec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Exchange is one recorded request and its response.
+type Exchange struct {
+	Seq    int         `json:"seq"`
+	Time   time.Time   `json:"time"` // When the response was received
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+// key identifies the request an exchange answers.
+func (e *Exchange) key() string {
+	return e.Method + " " + e.URL
+}
+
+// Recorder is an http.RoundTripper that saves every exchange to a directory.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	seq int
+}
+
+// NewRecorder creates dir if needed and records into it. Recording into a
+// directory that already holds a session appends to it.
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create recording directory: %w", err)
+	}
+
+	existing, err := loadExchanges(dir)
+	if err != nil {
+		return nil, err
+	}
+	seq := 0
+	for _, exchange := range existing {
+		if exchange.Seq > seq {
+			seq = exchange.Seq
+		}
+	}
+
+	return &Recorder{dir: dir, next: next, seq: seq}, nil
+}
+
+// RoundTrip sends the request and records the response before returning it.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	r.seq++
+	exchange := &Exchange{
+		Seq:    r.seq,
+		Time:   time.Now(),
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: resp.Header.Clone(),
+		Body:   string(body),
+	}
+	r.mu.Unlock()
+
+	// Best-effort: a failed write shouldn't break the session being recorded
+	_ = r.save(exchange)
+
+	return resp, nil
+}
+
+// save writes one exchange to its own file.
+func (r *Recorder) save(exchange *Exchange) error {
+	encoded, err := json.MarshalIndent(exchange, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(r.dir, fmt.Sprintf("%06d.json", exchange.Seq))
+	return os.WriteFile(path, encoded, 0644)
+}
+
+// Replayer is an http.RoundTripper that answers requests from a recording.
+//
+// Replay runs on a clock that starts at the recording's first exchange and
+// advances in real time. A request gets the latest response recorded for it
+// up to that clock, so polling the same URL walks through the recorded
+// responses in timestamp order, as it did live.
+type Replayer struct {
+	exchanges map[string][]*Exchange // By request, oldest first
+	start     time.Time              // Time of the first recorded exchange
+	began     time.Time              // When the replay started
+}
+
+// NewReplayer loads the recording in dir.
+func NewReplayer(dir string) (*Replayer, error) {
+	exchanges, err := loadExchanges(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(exchanges) == 0 {
+		return nil, fmt.Errorf("no recorded exchanges in %s", dir)
+	}
+
+	byKey := make(map[string][]*Exchange)
+	for _, exchange := range exchanges {
+		byKey[exchange.key()] = append(byKey[exchange.key()], exchange)
+	}
+
+	return &Replayer{
+		exchanges: byKey,
+		start:     exchanges[0].Time,
+		began:     time.Now(),
+	}, nil
+}
+
+// Start returns the time the recording started.
+func (r *Replayer) Start() time.Time {
+	return r.start
+}
+
+// Offset returns how far the replay clock is from the wall clock.
+// Pass it to data.SetClockOffset so dates follow the recording.
+func (r *Replayer) Offset() time.Duration {
+	return r.start.Sub(r.began)
+}
+
+// Now returns the replay clock.
+func (r *Replayer) Now() time.Time {
+	return time.Now().Add(r.Offset())
+}
+
+// RoundTrip answers the request with the matching recorded response.
+// Requests that weren't recorded fail like a network error would.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	exchange := r.lookup(req.Method+" "+req.URL.String(), r.Now())
+	if exchange == nil {
+		return nil, fmt.Errorf("replay: no recorded response for %s %s", req.Method, req.URL)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", exchange.Status, http.StatusText(exchange.Status)),
+		StatusCode:    exchange.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        exchange.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(exchange.Body)),
+		ContentLength: int64(len(exchange.Body)),
+		Request:       req,
+	}, nil
+}
+
+// lookup returns the latest exchange for key recorded at or before now.
+// Before its first recording, a request gets the first response.
+func (r *Replayer) lookup(key string, now time.Time) *Exchange {
+	recorded := r.exchanges[key]
+	if len(recorded) == 0 {
+		return nil
+	}
+
+	// Index of the first exchange recorded after now
+	i := sort.Search(len(recorded), func(i int) bool {
+		return recorded[i].Time.After(now)
+	})
+	if i == 0 {
+		return recorded[0]
+	}
+	return recorded[i-1]
+}
+
+// loadExchanges reads every exchange in dir, ordered by time.
+func loadExchanges(dir string) ([]*Exchange, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	exchanges := make([]*Exchange, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read recording: %w", err)
+		}
+		var exchange Exchange
+		if err := json.Unmarshal(raw, &exchange); err != nil {
+			return nil, fmt.Errorf("decode recording %s: %w", filepath.Base(path), err)
+		}
+		exchanges = append(exchanges, &exchange)
+	}
+
+	sort.SliceStable(exchanges, func(i, j int) bool {
+		if exchanges[i].Time.Equal(exchanges[j].Time) {
+			return exchanges[i].Seq < exchanges[j].Seq
+		}
+		return exchanges[i].Time.Before(exchanges[j].Time)
+	})
+	return exchanges, nil
+}
@@ -0,0 +1,121 @@
+package httprec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	calls := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, calls))
+		fmt.Fprintf(w, `{"call":%d}`, calls)
+	}))
+	defer upstream.Close()
+	dir := t.TempDir()
+
+	recorder, err := NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, body := get(t, &http.Client{Transport: recorder}, upstream.URL+"/matches"); body != `{"call":1}` {
+		t.Fatalf("recorded response = %s, want it passed through", body)
+	}
+
+	// A second recording appends to the session
+	recorder, err = NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get(t, &http.Client{Transport: recorder}, upstream.URL+"/table")
+	exchanges, err := loadExchanges(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchanges) != 2 || exchanges[1].Seq != 2 || exchanges[1].Header.Get("ETag") != `"2"` {
+		t.Fatalf("exchanges = %+v, want two in sequence with their headers", exchanges)
+	}
+
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offline := &http.Client{Transport: replayer}
+	if status, body := get(t, offline, upstream.URL+"/table"); status != http.StatusOK || body != `{"call":2}` {
+		t.Errorf("replayed /table = %d %s, want the recorded response", status, body)
+	}
+	if _, err := offline.Get(upstream.URL + "/never-recorded"); err == nil {
+		t.Error("request that wasn't recorded succeeded")
+	}
+	if calls != 2 {
+		t.Errorf("upstream got %d requests, want none during replay", calls)
+	}
+}
+
+func TestReplayFollowsTheRecordingClock(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2025, 5, 10, 15, 0, 0, 0, time.UTC)
+	for i, body := range []string{"0-0", "1-0", "1-1"} {
+		exchange := Exchange{Seq: i + 1, Time: start.Add(time.Duration(i) * time.Minute), Method: http.MethodGet, URL: "https://example.com/live", Status: http.StatusOK, Body: body}
+		raw, _ := json.Marshal(exchange)
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("%06d.json", i+1)), raw, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := replayer.Now(); got.Before(start) || got.After(start.Add(time.Minute)) {
+		t.Errorf("replay clock = %v, want the recording start %v", got, start)
+	}
+	if offset := replayer.Offset(); time.Now().Add(offset).Sub(start).Abs() > time.Second {
+		t.Errorf("Offset() = %v, want the wall clock moved back to %v", offset, start)
+	}
+
+	tests := []struct {
+		elapsed time.Duration
+		want    string
+	}{
+		{-time.Minute, "0-0"}, // Before the first recording
+		{0, "0-0"},
+		{90 * time.Second, "1-0"},
+		{2 * time.Minute, "1-1"},
+		{time.Hour, "1-1"},
+	}
+	for _, tt := range tests {
+		exchange := replayer.lookup("GET https://example.com/live", start.Add(tt.elapsed))
+		if exchange == nil || exchange.Body != tt.want {
+			t.Errorf("%v into the replay: got %+v, want %s", tt.elapsed, exchange, tt.want)
+		}
+	}
+}
+
+func TestReplayEmptyRecording(t *testing.T) {
+	if _, err := NewReplayer(t.TempDir()); err == nil {
+		t.Error("NewReplayer() on an empty directory succeeded")
+	}
+}
@@ -0,0 +1,99 @@
+// Package matchdiff compares consecutive snapshots of a match and reports
+// what happened in between as typed changes: goals, disallowed goals,
+// kickoff and the other status changes, cards, penalties, substitutions and
+// line-ups.
+//
+// The live view and notifications both consume these changes instead of
+// comparing scores or event IDs themselves.
+package matchdiff
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// Kind identifies the type of a Change.
+type Kind string
+
+const (
+	KindScoreChanged     Kind = "score_changed"
+	KindGoalDisallowed   Kind = "goal_disallowed"
+	KindStatusChanged    Kind = "status_changed"
+	KindCardShown        Kind = "card_shown"
+	KindSubstitutionMade Kind = "substitution_made"
+	KindLineupAnnounced  Kind = "lineup_announced"
+	KindPenaltyAwarded   Kind = "penalty_awarded"
+	KindEventEdited      Kind = "event_edited"
+)
+
+// Change is something that happened between two snapshots of a match.
+// Use a type switch to get at the details.
+type Change interface {
+	Kind() Kind
+}
+
+// ScoreChanged reports a goal. Goal is the scoring event, or nil when the
+// score moved before the event was published.
+type ScoreChanged struct {
+	Team         api.Team // Team credited with the goal
+	Home, Away   int      // Score after the goal
+	PreviousHome int
+	PreviousAway int
+	Goal         *api.MatchEvent
+}
+
+// GoalDisallowed reports a goal taken back (e.g., cancelled by VAR). Goal is
+// the withdrawn event, or nil when only the score went down.
+type GoalDisallowed struct {
+	Team       api.Team
+	Home, Away int // Score after the goal was taken back
+	Goal       *api.MatchEvent
+}
+
+// StatusChanged reports the match moving to another phase: kickoff,
+// half-time, the second half, extra time, penalties or full-time.
+type StatusChanged struct {
+	From, To Phase
+	Clock    string // Clock shown with the new phase ("HT", "46'", "FT"), if any
+}
+
+// CardShown reports a booking. Red is set for straight reds and second
+// yellows, including a yellow later upgraded to red.
+type CardShown struct {
+	Card api.MatchEvent
+	Red  bool
+}
+
+// SubstitutionMade reports a substitution. Player goes off and Assist comes on,
+// as in api.MatchEvent.
+type SubstitutionMade struct {
+	Substitution api.MatchEvent
+}
+
+// LineupAnnounced reports the starting line-ups being published.
+type LineupAnnounced struct {
+	HomeFormation string
+	AwayFormation string
+	Home, Away    []api.PlayerInfo
+}
+
+// PenaltyAwarded reports a penalty during play (not a shootout kick).
+// Providers only publish a penalty once it is taken, so it comes with the
+// goal (and its ScoreChanged) or the miss.
+type PenaltyAwarded struct {
+	Penalty api.MatchEvent
+	Scored  bool
+}
+
+// EventEdited reports a published event that was corrected (different minute,
+// player, assist or team). Changes covered by the other types, such as a goal
+// being withdrawn, are not reported as edits.
+type EventEdited struct {
+	Before, After api.MatchEvent
+}
+
+func (ScoreChanged) Kind() Kind     { return KindScoreChanged }
+func (GoalDisallowed) Kind() Kind   { return KindGoalDisallowed }
+func (StatusChanged) Kind() Kind    { return KindStatusChanged }
+func (CardShown) Kind() Kind        { return KindCardShown }
+func (SubstitutionMade) Kind() Kind { return KindSubstitutionMade }
+func (LineupAnnounced) Kind() Kind  { return KindLineupAnnounced }
+func (PenaltyAwarded) Kind() Kind   { return KindPenaltyAwarded }
+func (EventEdited) Kind() Kind      { return KindEventEdited }
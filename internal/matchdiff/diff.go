@@ -0,0 +1,317 @@
+package matchdiff
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Diff returns what changed from prev to cur, two snapshots of the same match.
+// It returns nil when prev is nil (there is nothing to compare against yet)
+// or the snapshots are of different matches.
+//
+// Changes come in the order they happened: line-ups, kickoff, then the
+// match events by minute, then any other status change (e.g., full-time).
+func Diff(prev, cur *api.MatchDetails) []Change {
+	if prev == nil || cur == nil || prev.ID != cur.ID {
+		return nil
+	}
+
+	var changes []Change
+	if !HasLineup(prev) && HasLineup(cur) {
+		changes = append(changes, LineupAnnounced{
+			HomeFormation: cur.HomeFormation,
+			AwayFormation: cur.AwayFormation,
+			Home:          startingPlayers(cur.HomeStarting, cur.HomeLineup),
+			Away:          startingPlayers(cur.AwayStarting, cur.AwayLineup),
+		})
+	}
+
+	status, kickoff := statusChange(prev, cur)
+	if status != nil && kickoff {
+		changes = append(changes, *status)
+	}
+	changes = append(changes, eventChanges(prev, cur)...)
+	if status != nil && !kickoff {
+		changes = append(changes, *status)
+	}
+	return changes
+}
+
+// statusChange returns the phase change between the snapshots, if any, and
+// whether it is the kickoff. Moves back to an earlier phase of play are
+// ignored, as are moves to or from an unknown phase other than the kickoff;
+// both come from a clock that lags behind.
+func statusChange(prev, cur *api.MatchDetails) (*StatusChanged, bool) {
+	from, to := PhaseOf(prev.Match), PhaseOf(cur.Match)
+	if from == to {
+		return nil, false
+	}
+
+	kickoff := from == PhaseNotStarted && to != PhasePostponed && to != PhaseCancelled && to != PhaseFinished
+	if !kickoff {
+		if from == PhaseUnknown || to == PhaseUnknown {
+			return nil, false
+		}
+		if inPlay(from) && inPlay(to) && to < from {
+			return nil, false
+		}
+	}
+
+	change := &StatusChanged{From: from, To: to}
+	if cur.LiveTime != nil {
+		change.Clock = *cur.LiveTime
+	}
+	return change, kickoff
+}
+
+// inPlay reports whether the phase is between kickoff and the final whistle.
+func inPlay(p Phase) bool {
+	return p >= PhaseFirstHalf && p <= PhasePenalties
+}
+
+// timed is an event change and the minute it sorts by.
+type timed struct {
+	minute int
+	change Change
+}
+
+// eventChanges compares the event lists and scores of the snapshots.
+// Events are matched by ID.
+func eventChanges(prev, cur *api.MatchDetails) []Change {
+	before := make(map[int]api.MatchEvent, len(prev.Events))
+	for _, e := range prev.Events {
+		before[e.ID] = e
+	}
+	after := make(map[int]bool, len(cur.Events))
+
+	var out []timed
+	var newGoals, withdrawn []api.MatchEvent
+
+	for _, e := range cur.Events {
+		after[e.ID] = true
+		old, seen := before[e.ID]
+		switch {
+		case !seen:
+			switch eventKind(e) {
+			case "goal":
+				newGoals = append(newGoals, e)
+				if isPenalty(e) {
+					out = append(out, timed{e.Minute, PenaltyAwarded{Penalty: e, Scored: true}})
+				}
+			case "missedpenalty":
+				out = append(out, timed{e.Minute, PenaltyAwarded{Penalty: e}})
+			case "card":
+				out = append(out, timed{e.Minute, CardShown{Card: e, Red: isRed(e)}})
+			case "substitution":
+				out = append(out, timed{e.Minute, SubstitutionMade{Substitution: e}})
+			}
+
+		case eventKind(old) == "goal" && eventKind(e) != "goal":
+			// A goal turned into something else (e.g., a VAR review)
+			withdrawn = append(withdrawn, old)
+
+		case eventKind(old) != "goal" && eventKind(e) == "goal":
+			newGoals = append(newGoals, e)
+
+		case eventKind(e) == "card" && isRed(e) && !isRed(old):
+			// Yellow upgraded to red
+			out = append(out, timed{e.Minute, CardShown{Card: e, Red: true}})
+
+		case !sameEvent(old, e):
+			out = append(out, timed{e.Minute, EventEdited{Before: old, After: e}})
+		}
+	}
+	for _, e := range prev.Events {
+		if !after[e.ID] && eventKind(e) == "goal" {
+			withdrawn = append(withdrawn, e)
+		}
+	}
+
+	out = append(out, scoreChanges(prev, cur, newGoals, withdrawn)...)
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].minute < out[j].minute })
+	changes := make([]Change, len(out))
+	for i, t := range out {
+		changes[i] = t.change
+	}
+	return changes
+}
+
+// scoreChanges turns the new and withdrawn goals into score changes,
+// replaying them against the score so each change carries the score it left.
+// A rise the new goals don't explain goes to goals listed before the score
+// counted them; any other move is reported last, without a goal.
+func scoreChanges(prev, cur *api.MatchDetails, newGoals, withdrawn []api.MatchEvent) []timed {
+	home, away := score(prev.HomeScore), score(prev.AwayScore)
+	curHome, curAway := score(cur.HomeScore), score(cur.AwayScore)
+
+	var out []timed
+	for _, goal := range withdrawn {
+		switch {
+		case isHome(goal, cur) && home > curHome:
+			home--
+		case !isHome(goal, cur) && away > curAway:
+			away--
+		default:
+			// Score not reduced for this goal yet
+			continue
+		}
+		out = append(out, timed{goal.Minute, GoalDisallowed{Team: goal.Team, Home: home, Away: away, Goal: &goal}})
+	}
+
+	sort.SliceStable(newGoals, func(i, j int) bool { return newGoals[i].Minute < newGoals[j].Minute })
+	for _, goal := range newGoals {
+		prevHome, prevAway := home, away
+		switch {
+		case isHome(goal, cur) && home < curHome:
+			home++
+		case !isHome(goal, cur) && away < curAway:
+			away++
+		default:
+			// Score not updated for this goal yet
+			continue
+		}
+		out = append(out, timed{goal.Minute, ScoreChanged{
+			Team: goal.Team, Home: home, Away: away,
+			PreviousHome: prevHome, PreviousAway: prevAway, Goal: &goal,
+		}})
+	}
+
+	// Score catching up with goals that arrived before it
+	homeGoals, awayGoals := uncounted(prev, cur, true), uncounted(prev, cur, false)
+	for ; home < curHome && len(homeGoals) > 0; home++ {
+		goal := homeGoals[0]
+		homeGoals = homeGoals[1:]
+		out = append(out, timed{goal.Minute, ScoreChanged{
+			Team: goal.Team, Home: home + 1, Away: away,
+			PreviousHome: home, PreviousAway: away, Goal: &goal,
+		}})
+	}
+	for ; away < curAway && len(awayGoals) > 0; away++ {
+		goal := awayGoals[0]
+		awayGoals = awayGoals[1:]
+		out = append(out, timed{goal.Minute, ScoreChanged{
+			Team: goal.Team, Home: home, Away: away + 1,
+			PreviousHome: home, PreviousAway: away, Goal: &goal,
+		}})
+	}
+
+	// Score moves the events don't explain
+	for ; home < curHome; home++ {
+		out = append(out, timed{math.MaxInt, ScoreChanged{Team: cur.HomeTeam, Home: home + 1, Away: away, PreviousHome: home, PreviousAway: away}})
+	}
+	for ; away < curAway; away++ {
+		out = append(out, timed{math.MaxInt, ScoreChanged{Team: cur.AwayTeam, Home: home, Away: away + 1, PreviousHome: home, PreviousAway: away}})
+	}
+	for ; home > curHome; home-- {
+		out = append(out, timed{math.MaxInt, GoalDisallowed{Team: cur.HomeTeam, Home: home - 1, Away: away}})
+	}
+	for ; away > curAway; away-- {
+		out = append(out, timed{math.MaxInt, GoalDisallowed{Team: cur.AwayTeam, Home: home, Away: away - 1}})
+	}
+	return out
+}
+
+// uncounted returns one side's goals, as they stand in cur, that prev
+// already listed but its score didn't include yet, earliest first.
+func uncounted(prev, cur *api.MatchDetails, homeSide bool) []api.MatchEvent {
+	current := make(map[int]api.MatchEvent, len(cur.Events))
+	for _, e := range cur.Events {
+		if eventKind(e) == "goal" {
+			current[e.ID] = e
+		}
+	}
+
+	var goals []api.MatchEvent
+	for _, e := range prev.Events {
+		if eventKind(e) == "goal" && isHome(e, prev) == homeSide {
+			goals = append(goals, e)
+		}
+	}
+	sort.SliceStable(goals, func(i, j int) bool { return goals[i].Minute < goals[j].Minute })
+
+	counted := score(prev.AwayScore)
+	if homeSide {
+		counted = score(prev.HomeScore)
+	}
+	var out []api.MatchEvent
+	for i, e := range goals {
+		if goal, ok := current[e.ID]; ok && i >= counted {
+			out = append(out, goal)
+		}
+	}
+	return out
+}
+
+// eventKind returns the normalized event type.
+func eventKind(e api.MatchEvent) string {
+	return strings.ToLower(e.Type)
+}
+
+// isRed reports whether a card event is a red or second yellow.
+func isRed(e api.MatchEvent) bool {
+	if e.EventType == nil {
+		return false
+	}
+	switch strings.ToLower(*e.EventType) {
+	case "red", "redcard", "secondyellow":
+		return true
+	}
+	return false
+}
+
+// isPenalty reports whether a goal was scored from the penalty spot.
+func isPenalty(e api.MatchEvent) bool {
+	return e.EventType != nil && strings.ToLower(*e.EventType) == "penalty"
+}
+
+// isHome reports whether an event belongs to the home team, falling back to
+// the short name when the event has no team ID.
+func isHome(e api.MatchEvent, details *api.MatchDetails) bool {
+	if e.Team.ID == 0 && e.Team.ShortName != "" {
+		return e.Team.ShortName == details.HomeTeam.ShortName
+	}
+	return e.Team.ID == details.HomeTeam.ID
+}
+
+// sameEvent compares everything but the timestamp, which providers set to
+// the time of the request.
+func sameEvent(a, b api.MatchEvent) bool {
+	return a.Minute == b.Minute && eventKind(a) == eventKind(b) && a.Team.ID == b.Team.ID &&
+		sameString(a.Player, b.Player) && sameString(a.Assist, b.Assist) && sameString(a.EventType, b.EventType)
+}
+
+func sameString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func score(s *int) int {
+	if s == nil {
+		return 0
+	}
+	return *s
+}
+
+// HasLineup reports whether a snapshot carries either starting line-up.
+func HasLineup(d *api.MatchDetails) bool {
+	return len(d.HomeStarting) > 0 || len(d.AwayStarting) > 0 || len(d.HomeLineup) > 0 || len(d.AwayLineup) > 0
+}
+
+// startingPlayers returns the starting players, built from the names when
+// the provider has no player details.
+func startingPlayers(starting []api.PlayerInfo, names []string) []api.PlayerInfo {
+	if len(starting) > 0 || len(names) == 0 {
+		return starting
+	}
+	players := make([]api.PlayerInfo, len(names))
+	for i, name := range names {
+		players[i] = api.PlayerInfo{Name: name}
+	}
+	return players
+}
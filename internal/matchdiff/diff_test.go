@@ -0,0 +1,249 @@
+package matchdiff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+var (
+	home = api.Team{ID: 1, ShortName: "ARS"}
+	away = api.Team{ID: 2, ShortName: "CHE"}
+)
+
+// snapshot builds match details at a clock ("HT", "67'"; "" before kickoff,
+// "FT" after full-time) with a score and events.
+func snapshot(clock string, homeScore, awayScore int, events ...api.MatchEvent) *api.MatchDetails {
+	d := &api.MatchDetails{Match: api.Match{ID: 7, HomeTeam: home, AwayTeam: away}, Events: events}
+	switch clock {
+	case "":
+		d.Status = api.MatchStatusNotStarted
+		return d
+	case "FT":
+		d.Status = api.MatchStatusFinished
+	default:
+		d.Status = api.MatchStatusLive
+	}
+	d.LiveTime = &clock
+	d.HomeScore, d.AwayScore = &homeScore, &awayScore
+	return d
+}
+
+func event(id, minute int, kind string, team api.Team, player, detail string) api.MatchEvent {
+	e := api.MatchEvent{ID: id, Minute: minute, Type: kind, Team: team, Player: &player}
+	if detail != "" {
+		e.EventType = &detail
+	}
+	return e
+}
+
+// kinds returns the kinds of the changes, in order.
+func kinds(changes []Change) []Kind {
+	var out []Kind
+	for _, c := range changes {
+		out = append(out, c.Kind())
+	}
+	return out
+}
+
+func TestDiffNeedsTwoSnapshotsOfTheSameMatch(t *testing.T) {
+	cur := snapshot("10'", 1, 0, event(1, 9, "goal", home, "Saka", ""))
+	if changes := Diff(nil, cur); changes != nil {
+		t.Errorf("Diff(nil, cur) = %v, want nil", changes)
+	}
+	other := snapshot("10'", 0, 0)
+	other.ID = 8
+	if changes := Diff(other, cur); changes != nil {
+		t.Errorf("Diff across matches = %v, want nil", changes)
+	}
+	if changes := Diff(cur, cur); len(changes) != 0 {
+		t.Errorf("Diff(cur, cur) = %v, want none", changes)
+	}
+}
+
+func TestDiffKickoffAndGoals(t *testing.T) {
+	prev := snapshot("", 0, 0)
+	cur := snapshot("20'", 1, 1,
+		event(2, 17, "goal", away, "Palmer", ""),
+		event(1, 4, "goal", home, "Saka", ""),
+		event(3, 12, "card", away, "Caicedo", "yellow"),
+	)
+	cur.HomeStarting = []api.PlayerInfo{{Name: "Raya"}}
+
+	changes := Diff(prev, cur)
+	want := []Kind{KindLineupAnnounced, KindStatusChanged, KindScoreChanged, KindCardShown, KindScoreChanged}
+	if got := kinds(changes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("kinds = %v, want %v", got, want)
+	}
+
+	if status := changes[1].(StatusChanged); status.From != PhaseNotStarted || status.To != PhaseFirstHalf {
+		t.Errorf("status = %+v, want kickoff", status)
+	}
+	first, second := changes[2].(ScoreChanged), changes[4].(ScoreChanged)
+	if *first.Goal.Player != "Saka" || first.Home != 1 || first.Away != 0 {
+		t.Errorf("first goal = %+v, want Saka 1-0", first)
+	}
+	if *second.Goal.Player != "Palmer" || second.Home != 1 || second.Away != 1 || second.PreviousHome != 1 {
+		t.Errorf("second goal = %+v, want Palmer 1-1 from 1-0", second)
+	}
+}
+
+func TestDiffScoreBeforeEvent(t *testing.T) {
+	prev := snapshot("30'", 0, 0)
+	cur := snapshot("31'", 1, 0)
+
+	changes := Diff(prev, cur)
+	if len(changes) != 1 {
+		t.Fatalf("changes = %v, want one", changes)
+	}
+	goal := changes[0].(ScoreChanged)
+	if goal.Goal != nil || goal.Team.ID != home.ID || goal.Home != 1 {
+		t.Errorf("goal = %+v, want 1-0 to the home side without an event", goal)
+	}
+
+	// The event arriving later doesn't count the goal twice
+	late := snapshot("32'", 1, 0, event(1, 30, "goal", home, "Saka", ""))
+	if changes := Diff(cur, late); len(changes) != 0 {
+		t.Errorf("late event changes = %v, want none", changes)
+	}
+}
+
+func TestDiffGoalDisallowed(t *testing.T) {
+	goal := event(1, 60, "goal", away, "Palmer", "")
+	prev := snapshot("61'", 1, 1, event(9, 20, "goal", home, "Saka", ""), goal)
+
+	// Withdrawn event
+	cur := snapshot("63'", 1, 0, event(9, 20, "goal", home, "Saka", ""))
+	changes := Diff(prev, cur)
+	if len(changes) != 1 {
+		t.Fatalf("changes = %v, want one", changes)
+	}
+	disallowed := changes[0].(GoalDisallowed)
+	if disallowed.Goal == nil || *disallowed.Goal.Player != "Palmer" || disallowed.Home != 1 || disallowed.Away != 0 {
+		t.Errorf("disallowed = %+v, want Palmer's goal and 1-0", disallowed)
+	}
+
+	// Score corrected, event left in place
+	cur = snapshot("63'", 1, 0, event(9, 20, "goal", home, "Saka", ""), goal)
+	changes = Diff(prev, cur)
+	if got := kinds(changes); !reflect.DeepEqual(got, []Kind{KindGoalDisallowed}) {
+		t.Fatalf("kinds = %v, want one disallowed goal", got)
+	}
+	if disallowed := changes[0].(GoalDisallowed); disallowed.Goal != nil || disallowed.Team.ID != away.ID {
+		t.Errorf("disallowed = %+v, want the away side without an event", disallowed)
+	}
+}
+
+func TestDiffGoalWithdrawnBeforeScore(t *testing.T) {
+	saka := event(9, 20, "goal", home, "Saka", "")
+	prev := snapshot("61'", 1, 1, saka, event(1, 60, "goal", away, "Palmer", ""))
+
+	// The event goes before the score drops: nothing to report yet
+	withdrawn := snapshot("62'", 1, 1, saka)
+	if changes := Diff(prev, withdrawn); len(changes) != 0 {
+		t.Fatalf("changes = %v, want none until the score drops", changes)
+	}
+
+	// The score catching up is reported once
+	corrected := snapshot("63'", 1, 0, saka)
+	changes := Diff(withdrawn, corrected)
+	if got := kinds(changes); !reflect.DeepEqual(got, []Kind{KindGoalDisallowed}) {
+		t.Fatalf("kinds = %v, want one disallowed goal", got)
+	}
+	if disallowed := changes[0].(GoalDisallowed); disallowed.Team.ID != away.ID || disallowed.Home != 1 || disallowed.Away != 0 {
+		t.Errorf("disallowed = %+v, want the away side and 1-0", disallowed)
+	}
+}
+
+func TestDiffGoalBeforeScore(t *testing.T) {
+	saka := event(9, 20, "goal", home, "Saka", "")
+	prev := snapshot("20'", 0, 0)
+
+	// The event goes before the score rises: nothing to report yet
+	early := snapshot("21'", 0, 0, saka)
+	if changes := Diff(prev, early); len(changes) != 0 {
+		t.Fatalf("changes = %v, want none until the score rises", changes)
+	}
+
+	// The score catching up is credited to the goal
+	counted := snapshot("22'", 1, 0, saka)
+	changes := Diff(early, counted)
+	if got := kinds(changes); !reflect.DeepEqual(got, []Kind{KindScoreChanged}) {
+		t.Fatalf("kinds = %v, want one score change", got)
+	}
+	goal := changes[0].(ScoreChanged)
+	if goal.Goal == nil || *goal.Goal.Player != "Saka" || goal.Team.ID != home.ID || goal.Home != 1 || goal.Away != 0 {
+		t.Errorf("goal = %+v, want Saka's goal and 1-0", goal)
+	}
+}
+
+func TestDiffStatusChanges(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     *StatusChanged
+	}{
+		{"45+2'", "HT", &StatusChanged{From: PhaseFirstHalf, To: PhaseHalfTime, Clock: "HT"}},
+		{"HT", "46'", &StatusChanged{From: PhaseHalfTime, To: PhaseSecondHalf, Clock: "46'"}},
+		{"90+4'", "FT", &StatusChanged{From: PhaseSecondHalf, To: PhaseFinished, Clock: "FT"}},
+		{"90+5'", "ET", &StatusChanged{From: PhaseSecondHalf, To: PhaseExtraTime, Clock: "ET"}},
+		{"120+1'", "Pen", &StatusChanged{From: PhaseExtraTime, To: PhasePenalties, Clock: "Pen"}},
+		{"44'", "45+1'", nil},
+		{"46'", "45+3'", nil}, // Clock lagging behind
+	}
+	for _, tt := range tests {
+		changes := Diff(snapshot(tt.from, 0, 0), snapshot(tt.to, 0, 0))
+		var got *StatusChanged
+		if len(changes) == 1 {
+			status := changes[0].(StatusChanged)
+			got = &status
+		}
+		if !reflect.DeepEqual(got, tt.want) || len(changes) > 1 {
+			t.Errorf("%s -> %s: changes = %v, want %v", tt.from, tt.to, changes, tt.want)
+		}
+	}
+}
+
+func TestDiffEventUpdates(t *testing.T) {
+	prev := snapshot("70'", 0, 0,
+		event(1, 30, "card", home, "Rice", "yellow"),
+		event(2, 50, "card", away, "James", "yellow"),
+	)
+	cur := snapshot("71'", 0, 0,
+		event(1, 31, "card", home, "Rice", "yellow"),
+		event(2, 50, "card", away, "James", "red"),
+		event(3, 70, "substitution", home, "Saka", ""),
+	)
+
+	changes := Diff(prev, cur)
+	want := []Kind{KindEventEdited, KindCardShown, KindSubstitutionMade}
+	if got := kinds(changes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("kinds = %v, want %v", got, want)
+	}
+	if card := changes[1].(CardShown); !card.Red || *card.Card.Player != "James" {
+		t.Errorf("card = %+v, want James sent off", card)
+	}
+	if edit := changes[0].(EventEdited); edit.Before.Minute != 30 || edit.After.Minute != 31 {
+		t.Errorf("edit = %+v, want 30' moved to 31'", edit)
+	}
+}
+
+func TestDiffPenalties(t *testing.T) {
+	prev := snapshot("60'", 0, 0)
+	cur := snapshot("75'", 1, 0,
+		event(1, 64, "goal", home, "Saka", "penalty"),
+		event(2, 71, "missedpenalty", away, "Palmer", ""),
+	)
+
+	changes := Diff(prev, cur)
+	want := []Kind{KindPenaltyAwarded, KindScoreChanged, KindPenaltyAwarded}
+	if got := kinds(changes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("kinds = %v, want %v", got, want)
+	}
+	if scored := changes[0].(PenaltyAwarded); !scored.Scored || *scored.Penalty.Player != "Saka" {
+		t.Errorf("first penalty = %+v, want Saka's goal", scored)
+	}
+	if missed := changes[2].(PenaltyAwarded); missed.Scored || *missed.Penalty.Player != "Palmer" {
+		t.Errorf("second penalty = %+v, want Palmer's miss", missed)
+	}
+}
@@ -0,0 +1,109 @@
+package matchdiff
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Phase is the part of the match being played.
+type Phase int
+
+const (
+	PhaseUnknown Phase = iota // Live, but the clock doesn't say where
+	PhaseNotStarted
+	PhaseFirstHalf
+	PhaseHalfTime
+	PhaseSecondHalf
+	PhaseExtraTime
+	PhasePenalties
+	PhaseFinished
+	PhasePostponed
+	PhaseCancelled
+)
+
+// String returns a readable name for the phase ("Half-time").
+func (p Phase) String() string {
+	switch p {
+	case PhaseNotStarted:
+		return "Not started"
+	case PhaseFirstHalf:
+		return "First half"
+	case PhaseHalfTime:
+		return "Half-time"
+	case PhaseSecondHalf:
+		return "Second half"
+	case PhaseExtraTime:
+		return "Extra time"
+	case PhasePenalties:
+		return "Penalties"
+	case PhaseFinished:
+		return "Full-time"
+	case PhasePostponed:
+		return "Postponed"
+	case PhaseCancelled:
+		return "Cancelled"
+	}
+	return "In play"
+}
+
+// PhaseOf returns the phase of a match from its status and live clock
+// ("23'", "45+2'", "HT", "ET", "Pen").
+func PhaseOf(match api.Match) Phase {
+	switch match.Status {
+	case api.MatchStatusNotStarted:
+		return PhaseNotStarted
+	case api.MatchStatusFinished:
+		return PhaseFinished
+	case api.MatchStatusPostponed:
+		return PhasePostponed
+	case api.MatchStatusCancelled:
+		return PhaseCancelled
+	}
+	if match.LiveTime == nil {
+		return PhaseUnknown
+	}
+
+	clock := strings.ToUpper(strings.TrimSpace(*match.LiveTime))
+	switch clock {
+	case "HT":
+		return PhaseHalfTime
+	case "ET", "ET HT", "AET":
+		// Breaks before and during extra time
+		return PhaseExtraTime
+	case "PEN", "PENS":
+		return PhasePenalties
+	}
+
+	minute, ok := clockMinute(clock)
+	switch {
+	case !ok:
+		return PhaseUnknown
+	case minute <= 45:
+		return PhaseFirstHalf
+	case minute <= 90:
+		return PhaseSecondHalf
+	}
+	return PhaseExtraTime
+}
+
+// ClockMinute returns the minute shown by a match's live clock ("45+2'" is
+// 45). It returns false when the clock shows no minute ("HT") or is unset.
+func ClockMinute(match api.Match) (int, bool) {
+	if match.LiveTime == nil {
+		return 0, false
+	}
+	return clockMinute(strings.TrimSpace(*match.LiveTime))
+}
+
+// clockMinute returns the minute at the start of a clock ("45+2'" is 45).
+func clockMinute(clock string) (int, bool) {
+	end := strings.IndexFunc(clock, func(r rune) bool { return !unicode.IsDigit(r) })
+	if end == -1 {
+		end = len(clock)
+	}
+	minute, err := strconv.Atoi(clock[:end])
+	return minute, err == nil
+}
@@ -0,0 +1,173 @@
+// Package mock implements api.Provider on top of the built-in mock data in
+// internal/data and simulated matches played from scenarios (see Scenario and
+// Simulation). It makes no network requests; `golazo --mock` selects it.
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// realPollInterval is how often the app polls a real live match.
+const realPollInterval = 90 * time.Second
+
+// Client serves the mock matches and the simulations.
+type Client struct {
+	simulations []*Simulation
+	speed       float64
+}
+
+// NewClient creates a mock data provider playing the built-in scenarios at
+// DefaultSpeed, starting now.
+func NewClient() *Client {
+	scenarios, _ := BuiltinScenarios()
+	return NewClientWithScenarios(scenarios, DefaultSpeed)
+}
+
+// NewClientWithScenarios creates a mock data provider playing scenarios,
+// speed match minutes per real minute (DefaultSpeed when 0), starting now.
+func NewClientWithScenarios(scenarios []*Scenario, speed float64) *Client {
+	if speed <= 0 {
+		speed = DefaultSpeed
+	}
+	c := &Client{speed: speed}
+	start := data.Now()
+	for _, scenario := range scenarios {
+		c.simulations = append(c.simulations, NewSimulation(scenario, start, speed))
+	}
+	return c
+}
+
+var (
+	_ api.Provider         = (*Client)(nil)
+	_ api.PollIntervalHint = (*Client)(nil)
+)
+
+// PollInterval shortens polling in step with the simulation clock, so each
+// poll covers about as much of a match as a real one would.
+func (c *Client) PollInterval() time.Duration {
+	return max(time.Duration(float64(realPollInterval)/c.speed), 2*time.Second)
+}
+
+// simulation returns the simulation playing matchID, or nil.
+func (c *Client) simulation(matchID int) *Simulation {
+	for _, sim := range c.simulations {
+		if sim.ID() == matchID {
+			return sim
+		}
+	}
+	return nil
+}
+
+// simulatedMatches returns the simulated matches as they stand now.
+func (c *Client) simulatedMatches() []api.Match {
+	now := data.Now()
+	matches := make([]api.Match, 0, len(c.simulations))
+	for _, sim := range c.simulations {
+		matches = append(matches, sim.Match(now))
+	}
+	return matches
+}
+
+// liveMatches returns the simulated matches in progress, then the mock live matches.
+func (c *Client) liveMatches() []api.Match {
+	var live []api.Match
+	for _, match := range c.simulatedMatches() {
+		if match.Status == api.MatchStatusLive {
+			live = append(live, match)
+		}
+	}
+	return append(live, data.MockLiveMatches()...)
+}
+
+// matches returns every mock match: simulated, live, then finished ones.
+func (c *Client) matches() []api.Match {
+	matches := c.simulatedMatches()
+	matches = append(matches, data.MockLiveMatches()...)
+	return append(matches, data.MockFinishedMatches()...)
+}
+
+// onDate keeps the matches played on date's calendar day.
+func onDate(matches []api.Match, date time.Time) []api.Match {
+	dateStr := data.DateKey(date)
+	var result []api.Match
+	for _, match := range matches {
+		if match.MatchTime != nil && data.DateKey(*match.MatchTime) == dateStr {
+			result = append(result, match)
+		}
+	}
+	return result
+}
+
+// MatchesByDate returns the mock matches played on date.
+func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return onDate(c.matches(), date), nil
+}
+
+// ResultsByDate returns the mock matches played on date.
+func (c *Client) ResultsByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.MatchesByDate(ctx, date)
+}
+
+// MatchDetails returns the details of a simulated, live or finished mock match.
+func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	if sim := c.simulation(matchID); sim != nil {
+		return sim.Details(data.Now()), nil
+	}
+
+	return data.MockMatchDetails(matchID)
+}
+
+// MatchDetailsForceRefresh is MatchDetails; mock data is never cached.
+func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return c.MatchDetails(ctx, matchID)
+}
+
+// Leagues returns no leagues, so the settings view keeps the built-in list.
+func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
+	return nil, nil
+}
+
+// LeagueMatches returns the current mock season of a league (Premier League only).
+func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
+	season, err := c.LeagueSeason(ctx, leagueID, "")
+	if err != nil || season == nil {
+		return nil, err
+	}
+	return season.Matches, nil
+}
+
+// LeagueSeason returns a mock season. Only the Premier League has one;
+// other leagues return nil.
+func (c *Client) LeagueSeason(ctx context.Context, leagueID int, season string) (*api.LeagueSeason, error) {
+	return data.MockLeagueSeason(leagueID, season), nil
+}
+
+// LeagueTable returns mock standings for a league.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	return data.MockLeagueTable(leagueID), nil
+}
+
+// LiveMatches returns the mock matches in progress.
+func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	return c.liveMatches(), nil
+}
+
+// LiveMatchesForceRefresh is LiveMatches; mock data is never cached.
+func (c *Client) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
+	return c.LiveMatches(ctx)
+}
+
+// LiveMatchesForLeague returns the mock matches in progress in a league.
+func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
+	var result []api.Match
+	for _, match := range c.liveMatches() {
+		if match.League.ID == leagueID {
+			result = append(result, match)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,290 @@
+package mock
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario describes a match for the simulation engine. Scenarios are YAML or
+// JSON files; see scenarios/ for the built-in ones.
+//
+// Minutes are match clock minutes: 1-45 first half, 46-90 second half and
+// 91-120 extra time. Events in stoppage time use the last minute of the half
+// plus "added" (e.g., minute 90, added 3 for 90+3').
+type Scenario struct {
+	ID         int            `yaml:"id"`
+	League     ScenarioLeague `yaml:"league"`
+	Round      string         `yaml:"round"`
+	Home       ScenarioTeam   `yaml:"home"`
+	Away       ScenarioTeam   `yaml:"away"`
+	Venue      string         `yaml:"venue"`
+	Referee    string         `yaml:"referee"`
+	Attendance int            `yaml:"attendance"`
+
+	// StartAt is the match minute the simulation begins at: 0 kicks off right
+	// away, negative values leave the match upcoming for that many minutes,
+	// and positive ones join it in progress (e.g., 100 joins in extra time).
+	StartAt int `yaml:"start_at"`
+
+	Stoppage  Stoppage        `yaml:"stoppage"`
+	ExtraTime bool            `yaml:"extra_time"` // Play 2x15 minutes after 90
+	Shootout  []ScenarioKick  `yaml:"shootout"`   // Penalty kicks in order, after the last period
+	Events    []ScenarioEvent `yaml:"events"`
+	Stats     []ScenarioStat  `yaml:"stats"` // Full-time values; they build up as the match is played
+}
+
+// ScenarioLeague is the competition a scenario is played in (FotMob league ID).
+type ScenarioLeague struct {
+	ID      int    `yaml:"id"`
+	Name    string `yaml:"name"`
+	Country string `yaml:"country"`
+}
+
+// ScenarioTeam is one side of a scenario, with an optional line-up.
+type ScenarioTeam struct {
+	ID        int      `yaml:"id"`
+	Name      string   `yaml:"name"`
+	ShortName string   `yaml:"short_name"`
+	Formation string   `yaml:"formation"`
+	Lineup    []string `yaml:"lineup"` // Starting players
+	Bench     []string `yaml:"bench"`
+}
+
+// Stoppage is the time added to each period, in minutes.
+type Stoppage struct {
+	FirstHalf   int `yaml:"first_half"`
+	SecondHalf  int `yaml:"second_half"`
+	ExtraFirst  int `yaml:"extra_first"`
+	ExtraSecond int `yaml:"extra_second"`
+}
+
+// Event types accepted in scenarios.
+const (
+	EventGoal         = "goal"
+	EventCard         = "card"
+	EventSubstitution = "substitution"
+)
+
+// ScenarioEvent is a scripted event.
+type ScenarioEvent struct {
+	Minute   int    `yaml:"minute"`
+	Added    int    `yaml:"added"` // Minutes into stoppage time
+	Type     string `yaml:"type"`  // "goal", "card" or "substitution"
+	Team     string `yaml:"team"`  // "home" or "away" (the team credited, own goals included)
+	Player   string `yaml:"player"`
+	Assist   string `yaml:"assist"`    // Goals only
+	Penalty  bool   `yaml:"penalty"`   // Goals only: scored from the spot
+	Card     string `yaml:"card"`      // Cards only: "yellow" or "red"
+	PlayerIn string `yaml:"player_in"` // Substitutions only; player goes off
+}
+
+// ScenarioKick is one penalty of a shootout.
+type ScenarioKick struct {
+	Team   string `yaml:"team"`
+	Player string `yaml:"player"`
+	Scored bool   `yaml:"scored"`
+}
+
+// ScenarioStat is a match statistic's full-time value. Counts (shots,
+// corners) grow with the match; shares (possession) start level and drift
+// towards their final value.
+type ScenarioStat struct {
+	Key   string  `yaml:"key"`
+	Label string  `yaml:"label"`
+	Home  float64 `yaml:"home"`
+	Away  float64 `yaml:"away"`
+	Share bool    `yaml:"share"` // Home and away add up to 100
+}
+
+// builtinScenarios are played by --mock unless scenario files are given.
+//
+//go:embed scenarios/*.yaml
+var builtinScenarios embed.FS
+
+// BuiltinScenarios returns the scenarios shipped with golazo.
+func BuiltinScenarios() ([]*Scenario, error) {
+	names, err := builtinScenarios.ReadDir("scenarios")
+	if err != nil {
+		return nil, err
+	}
+
+	scenarios := make([]*Scenario, 0, len(names))
+	for _, entry := range names {
+		raw, err := builtinScenarios.ReadFile("scenarios/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		scenario, err := ParseScenario(raw)
+		if err != nil {
+			return nil, fmt.Errorf("built-in scenario %s: %w", entry.Name(), err)
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios, nil
+}
+
+// LoadScenarios reads scenario files. A directory loads every .yaml, .yml
+// and .json file in it.
+func LoadScenarios(paths []string) ([]*Scenario, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("load scenario: %w", err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("load scenarios: %w", err)
+		}
+		for _, entry := range entries {
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".yaml", ".yml", ".json":
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+
+	scenarios := make([]*Scenario, 0, len(files))
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("load scenario: %w", err)
+		}
+		scenario, err := ParseScenario(raw)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %s: %w", file, err)
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios, nil
+}
+
+// ParseScenario decodes and validates a YAML or JSON scenario
+// (JSON is valid YAML, so one decoder reads both).
+func ParseScenario(raw []byte) (*Scenario, error) {
+	var scenario Scenario
+	if err := yaml.Unmarshal(raw, &scenario); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	if err := scenario.validate(); err != nil {
+		return nil, err
+	}
+	return &scenario, nil
+}
+
+// validate checks that every event fits in the periods the scenario plays.
+func (s *Scenario) validate() error {
+	if s.ID == 0 {
+		return fmt.Errorf("missing id")
+	}
+	if s.Home.Name == "" || s.Away.Name == "" {
+		return fmt.Errorf("missing team name")
+	}
+
+	lastMinute := 90
+	if s.ExtraTime {
+		lastMinute = 120
+	}
+	if s.StartAt > lastMinute {
+		return fmt.Errorf("start_at %d is past minute %d", s.StartAt, lastMinute)
+	}
+	for i, e := range s.Events {
+		if e.Minute < 1 || e.Minute > lastMinute {
+			return fmt.Errorf("event %d: minute %d outside 1-%d", i+1, e.Minute, lastMinute)
+		}
+		if e.Added > 0 && s.stoppageAfter(e.Minute) < e.Added {
+			return fmt.Errorf("event %d: %d+%d' is past the stoppage time", i+1, e.Minute, e.Added)
+		}
+		if e.Team != "home" && e.Team != "away" {
+			return fmt.Errorf("event %d: team must be home or away, got %q", i+1, e.Team)
+		}
+		switch e.Type {
+		case EventGoal:
+		case EventCard:
+			if e.Card != "yellow" && e.Card != "red" {
+				return fmt.Errorf("event %d: card must be yellow or red, got %q", i+1, e.Card)
+			}
+		case EventSubstitution:
+			if e.PlayerIn == "" {
+				return fmt.Errorf("event %d: substitution needs player_in", i+1)
+			}
+		default:
+			return fmt.Errorf("event %d: unknown type %q", i+1, e.Type)
+		}
+	}
+
+	for i, kick := range s.Shootout {
+		if kick.Team != "home" && kick.Team != "away" {
+			return fmt.Errorf("penalty %d: team must be home or away, got %q", i+1, kick.Team)
+		}
+	}
+	if len(s.Shootout) > 0 {
+		home, away := s.finalScore()
+		if home != away {
+			return fmt.Errorf("shootout after a %d-%d result", home, away)
+		}
+		pensHome, pensAway := s.shootoutScore(len(s.Shootout))
+		if pensHome == pensAway {
+			return fmt.Errorf("shootout ends level at %d-%d", pensHome, pensAway)
+		}
+	}
+	return nil
+}
+
+// stoppageAfter returns the stoppage time played after minute, or 0 when
+// minute doesn't end a period.
+func (s *Scenario) stoppageAfter(minute int) int {
+	switch minute {
+	case 45:
+		return s.Stoppage.FirstHalf
+	case 90:
+		return s.Stoppage.SecondHalf
+	case 105:
+		return s.Stoppage.ExtraFirst
+	case 120:
+		return s.Stoppage.ExtraSecond
+	}
+	return 0
+}
+
+// finalScore returns the score once every event has been played.
+func (s *Scenario) finalScore() (home, away int) {
+	for _, e := range s.Events {
+		if e.Type != EventGoal {
+			continue
+		}
+		if e.Team == "home" {
+			home++
+		} else {
+			away++
+		}
+	}
+	return home, away
+}
+
+// shootoutScore returns the shootout score after the first n kicks.
+func (s *Scenario) shootoutScore(n int) (home, away int) {
+	for _, kick := range s.Shootout[:n] {
+		if !kick.Scored {
+			continue
+		}
+		if kick.Team == "home" {
+			home++
+		} else {
+			away++
+		}
+	}
+	return home, away
+}
@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuiltinScenariosAreValid(t *testing.T) {
+	scenarios, err := BuiltinScenarios()
+	if err != nil {
+		t.Fatalf("BuiltinScenarios() error = %v", err)
+	}
+	if len(scenarios) == 0 {
+		t.Fatal("no built-in scenarios")
+	}
+}
+
+func TestParseScenarioRejects(t *testing.T) {
+	const teams = "id: 1\nhome: {name: Arsenal}\naway: {name: Chelsea}\n"
+
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"undecodable", "id: [1", "decode"},
+		{"no id", "home: {name: Arsenal}\naway: {name: Chelsea}\n", "missing id"},
+		{"no away team", "id: 1\nhome: {name: Arsenal}\n", "missing team name"},
+		{"start past full time", teams + "start_at: 91\n", "start_at 91 is past minute 90"},
+		{"minute in extra time without it", teams + "events: [{minute: 95, type: goal, team: home}]\n", "minute 95 outside 1-90"},
+		{"minute zero", teams + "events: [{minute: 0, type: goal, team: home}]\n", "minute 0 outside 1-90"},
+		{"past the stoppage", teams + "stoppage: {second_half: 3}\nevents: [{minute: 90, added: 4, type: goal, team: home}]\n", "90+4' is past the stoppage time"},
+		{"added before full time", teams + "events: [{minute: 60, added: 1, type: goal, team: home}]\n", "60+1' is past the stoppage time"},
+		{"unknown team", teams + "events: [{minute: 10, type: goal, team: visitors}]\n", `team must be home or away, got "visitors"`},
+		{"unknown card", teams + "events: [{minute: 10, type: card, team: home, card: green}]\n", `card must be yellow or red, got "green"`},
+		{"substitution without player in", teams + "events: [{minute: 60, type: substitution, team: away, player: Palmer}]\n", "substitution needs player_in"},
+		{"unknown type", teams + "events: [{minute: 10, type: offside, team: home}]\n", `unknown type "offside"`},
+		{"kick by unknown team", teams + "shootout: [{team: nobody, scored: true}]\n", `penalty 1: team must be home or away`},
+		{"shootout after a win", teams + "events: [{minute: 10, type: goal, team: home}]\nshootout: [{team: home, scored: true}]\n", "shootout after a 1-0 result"},
+		{"shootout ends level", teams + "shootout: [{team: home, scored: true}, {team: away, scored: true}]\n", "shootout ends level at 1-1"},
+	}
+	for _, tt := range tests {
+		_, err := ParseScenario([]byte(tt.yaml))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want it to mention %q", tt.name, err, tt.want)
+		}
+	}
+
+	if _, err := ParseScenario([]byte(teams + "extra_time: true\nstoppage: {extra_second: 2}\nevents: [{minute: 120, added: 2, type: goal, team: away}]\n")); err != nil {
+		t.Errorf("goal in extra-time stoppage: error = %v", err)
+	}
+}
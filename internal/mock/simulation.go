@@ -0,0 +1,414 @@
+package mock
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// DefaultSpeed is how many match minutes pass per real minute: a match with
+// half time and stoppage time takes about 8 minutes.
+const DefaultSpeed = 15.0
+
+// Break lengths, in match minutes.
+const (
+	halfTimeBreak  = 15
+	extraTimeBreak = 5 // Before extra time, and before a shootout
+	turnoverBreak  = 1 // Between the halves of extra time
+)
+
+// periodKind tells playing time from breaks.
+type periodKind int
+
+const (
+	periodPlay periodKind = iota
+	periodBreak
+	periodShootout
+)
+
+// period is a stretch of the match timeline.
+type period struct {
+	kind    periodKind
+	label   string  // Clock shown during breaks and the shootout
+	start   float64 // Timeline minutes since kickoff
+	length  float64
+	base    int // Clock minute when the period starts (0, 45, 90, 105)
+	regular int // Minutes before stoppage time (45 or 15)
+}
+
+// end returns the timeline minute the period ends at.
+func (p period) end() float64 {
+	return p.start + p.length
+}
+
+// timedEvent is a scenario event placed on the timeline.
+type timedEvent struct {
+	ScenarioEvent
+	id  int
+	pos float64
+}
+
+// Simulation plays a scenario on an accelerated clock. Its state is a pure
+// function of time: every call to Details for the same instant returns the
+// same match, so polling at any moment gives a consistent snapshot.
+type Simulation struct {
+	scenario *Scenario
+	start    time.Time // Real time the simulation began
+	speed    float64   // Match minutes per real minute
+	offset   float64   // Timeline minute at start
+	periods  []period
+	end      float64 // Timeline minute of the final whistle (or last penalty)
+	events   []timedEvent
+}
+
+// NewSimulation starts playing scenario at start, speed match minutes per
+// real minute (DefaultSpeed when 0).
+func NewSimulation(scenario *Scenario, start time.Time, speed float64) *Simulation {
+	if speed <= 0 {
+		speed = DefaultSpeed
+	}
+	s := &Simulation{scenario: scenario, start: start, speed: speed}
+
+	t := 0.0
+	add := func(p period) {
+		p.start = t
+		s.periods = append(s.periods, p)
+		t += p.length
+	}
+	stoppage := scenario.Stoppage
+	add(period{kind: periodPlay, base: 0, regular: 45, length: float64(45 + stoppage.FirstHalf)})
+	add(period{kind: periodBreak, label: "HT", length: halfTimeBreak})
+	add(period{kind: periodPlay, base: 45, regular: 45, length: float64(45 + stoppage.SecondHalf)})
+	if scenario.ExtraTime {
+		add(period{kind: periodBreak, label: "ET", length: extraTimeBreak})
+		add(period{kind: periodPlay, base: 90, regular: 15, length: float64(15 + stoppage.ExtraFirst)})
+		add(period{kind: periodBreak, label: "ET HT", length: turnoverBreak})
+		add(period{kind: periodPlay, base: 105, regular: 15, length: float64(15 + stoppage.ExtraSecond)})
+	}
+	if len(scenario.Shootout) > 0 {
+		add(period{kind: periodBreak, label: "Pen", length: extraTimeBreak})
+		add(period{kind: periodShootout, label: "Pen", length: float64(len(scenario.Shootout))})
+	}
+	s.end = t
+
+	for i, e := range scenario.Events {
+		s.events = append(s.events, timedEvent{ScenarioEvent: e, id: scenario.ID*1000 + i + 1, pos: s.position(e.Minute, e.Added)})
+	}
+	sort.SliceStable(s.events, func(i, j int) bool {
+		return s.events[i].pos < s.events[j].pos
+	})
+
+	switch {
+	case scenario.StartAt <= 0:
+		s.offset = float64(scenario.StartAt)
+	default:
+		s.offset = math.Min(s.position(scenario.StartAt, 0), s.end)
+	}
+	return s
+}
+
+// position returns the timeline minute a clock minute (plus stoppage) starts at.
+func (s *Simulation) position(minute, added int) float64 {
+	for _, p := range s.periods {
+		if p.kind == periodPlay && minute > p.base && minute <= p.base+p.regular {
+			return p.start + float64(minute-p.base-1+added)
+		}
+	}
+	return s.end
+}
+
+// timeline returns the timeline minute at now.
+func (s *Simulation) timeline(now time.Time) float64 {
+	return s.offset + now.Sub(s.start).Minutes()*s.speed
+}
+
+// realTime returns when the timeline reaches minute t.
+func (s *Simulation) realTime(t float64) time.Time {
+	return s.start.Add(time.Duration((t - s.offset) / s.speed * float64(time.Minute)))
+}
+
+// periodAt returns the period being played at timeline minute t.
+func (s *Simulation) periodAt(t float64) (period, bool) {
+	for _, p := range s.periods {
+		if t >= p.start && t < p.end() {
+			return p, true
+		}
+	}
+	return period{}, false
+}
+
+// status returns the match status and clock at timeline minute t.
+func (s *Simulation) status(t float64) (api.MatchStatus, *string) {
+	if t < 0 {
+		return api.MatchStatusNotStarted, nil
+	}
+	if t >= s.end {
+		clock := "FT"
+		if len(s.scenario.Shootout) > 0 {
+			clock = "Pen"
+		} else if s.scenario.ExtraTime {
+			clock = "AET"
+		}
+		return api.MatchStatusFinished, &clock
+	}
+
+	p, _ := s.periodAt(t)
+	if p.kind != periodPlay {
+		return api.MatchStatusLive, &p.label
+	}
+	played := int(t - p.start)
+	clock := fmt.Sprintf("%d'", p.base+played+1)
+	if played >= p.regular {
+		clock = fmt.Sprintf("%d+%d'", p.base+p.regular, played-p.regular+1)
+	}
+	return api.MatchStatusLive, &clock
+}
+
+// minutesPlayed returns the match minutes played by timeline minute t,
+// stoppage time excluded.
+func (s *Simulation) minutesPlayed(t float64) float64 {
+	played := 0.0
+	for _, p := range s.periods {
+		if p.kind != periodPlay || t <= p.start {
+			continue
+		}
+		played += math.Min(t-p.start, float64(p.regular))
+	}
+	return played
+}
+
+// ID returns the simulated match ID.
+func (s *Simulation) ID() int {
+	return s.scenario.ID
+}
+
+// Match returns the match as listed at now.
+func (s *Simulation) Match(now time.Time) api.Match {
+	return s.Details(now).Match
+}
+
+// Details returns the match details at now.
+func (s *Simulation) Details(now time.Time) *api.MatchDetails {
+	sc := s.scenario
+	t := s.timeline(now)
+	kickoff := s.realTime(0)
+
+	home := api.Team{ID: sc.Home.ID, Name: sc.Home.Name, ShortName: sc.Home.ShortName}
+	away := api.Team{ID: sc.Away.ID, Name: sc.Away.Name, ShortName: sc.Away.ShortName}
+	status, clock := s.status(t)
+
+	details := &api.MatchDetails{
+		Match: api.Match{
+			ID:        sc.ID,
+			League:    api.League{ID: sc.League.ID, Name: sc.League.Name, Country: sc.League.Country},
+			HomeTeam:  home,
+			AwayTeam:  away,
+			Status:    status,
+			MatchTime: &kickoff,
+			LiveTime:  clock,
+			Round:     sc.Round,
+		},
+		Venue:         sc.Venue,
+		Referee:       sc.Referee,
+		Attendance:    sc.Attendance,
+		MatchDuration: 90,
+		HomeFormation: sc.Home.Formation,
+		AwayFormation: sc.Away.Formation,
+		HomeLineup:    sc.Home.Lineup,
+		AwayLineup:    sc.Away.Lineup,
+		HomeStarting:  players(sc.Home.Lineup, 1),
+		AwayStarting:  players(sc.Away.Lineup, 1),
+	}
+	details.HomeSubstitutes = players(sc.Home.Bench, len(sc.Home.Lineup)+1)
+	details.AwaySubstitutes = players(sc.Away.Bench, len(sc.Away.Lineup)+1)
+	if status == api.MatchStatusNotStarted {
+		return details
+	}
+
+	// Events and score so far
+	homeScore, awayScore := 0, 0
+	details.Events = []api.MatchEvent{}
+	for _, e := range s.events {
+		if e.pos > t {
+			break
+		}
+		team := home
+		if e.Team == "away" {
+			team = away
+		}
+		event := api.MatchEvent{
+			ID:        e.id,
+			Minute:    e.Minute,
+			Type:      e.Type,
+			Team:      team,
+			Player:    stringPtr(e.Player),
+			Timestamp: s.realTime(e.pos),
+		}
+		switch e.Type {
+		case EventGoal:
+			if e.Team == "home" {
+				homeScore++
+			} else {
+				awayScore++
+			}
+			if e.Assist != "" {
+				event.Assist = stringPtr(e.Assist)
+			}
+			if e.Penalty {
+				event.EventType = stringPtr("penalty")
+			}
+		case EventCard:
+			event.EventType = stringPtr(e.Card)
+		case EventSubstitution:
+			// Player goes off, Assist comes on (as the FotMob client reports them)
+			event.Assist = stringPtr(e.PlayerIn)
+		}
+		details.Events = append(details.Events, event)
+	}
+	details.HomeScore = &homeScore
+	details.AwayScore = &awayScore
+
+	firstHalf := s.periods[0]
+	if t >= firstHalf.end() {
+		htHome, htAway := s.scoreBefore(firstHalf.end())
+		details.HalfTimeScore = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: &htHome, Away: &htAway}
+	}
+
+	if sc.ExtraTime && t >= s.position(91, 0) {
+		details.ExtraTime = true
+		details.MatchDuration = 120
+	}
+
+	// Shootout kicks so far
+	var pensHome, pensAway int
+	shootout := false
+	for _, p := range s.periods {
+		if p.kind != periodShootout || t < p.start {
+			continue
+		}
+		shootout = true
+		kicks := int(math.Min(t-p.start+1, float64(len(sc.Shootout))))
+		for i, kick := range sc.Shootout[:kicks] {
+			team, result := home, "missed"
+			if kick.Team == "away" {
+				team = away
+			}
+			if kick.Scored {
+				result = "scored"
+			}
+			details.Events = append(details.Events, api.MatchEvent{
+				ID:        sc.ID*1000 + 500 + i + 1,
+				Minute:    120,
+				Type:      "penalty",
+				Team:      team,
+				Player:    stringPtr(kick.Player),
+				EventType: stringPtr(result),
+				Timestamp: s.realTime(p.start + float64(i)),
+			})
+		}
+		pensHome, pensAway = sc.shootoutScore(kicks)
+	}
+	if shootout {
+		details.Penalties = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: &pensHome, Away: &pensAway}
+	}
+
+	if status == api.MatchStatusFinished {
+		switch {
+		case homeScore > awayScore, homeScore == awayScore && pensHome > pensAway:
+			details.Winner = stringPtr("home")
+		case awayScore > homeScore, homeScore == awayScore && pensAway > pensHome:
+			details.Winner = stringPtr("away")
+		}
+	}
+
+	details.Statistics = s.statistics(t, status == api.MatchStatusFinished)
+	return details
+}
+
+// scoreBefore returns the score at timeline minute t.
+func (s *Simulation) scoreBefore(t float64) (home, away int) {
+	for _, e := range s.events {
+		if e.pos >= t {
+			break
+		}
+		if e.Type != EventGoal {
+			continue
+		}
+		if e.Team == "home" {
+			home++
+		} else {
+			away++
+		}
+	}
+	return home, away
+}
+
+// statistics returns the scenario's stats as they stand at timeline minute t.
+// Counts grow in proportion to the minutes played; shares start level and
+// drift towards the final value, wobbling a little from minute to minute.
+func (s *Simulation) statistics(t float64, finished bool) []api.MatchStatistic {
+	total := 90.0
+	if s.scenario.ExtraTime {
+		total = 120
+	}
+	progress := math.Min(s.minutesPlayed(t)/total, 1)
+	if finished {
+		progress = 1
+	}
+
+	stats := make([]api.MatchStatistic, 0, len(s.scenario.Stats))
+	for i, stat := range s.scenario.Stats {
+		home, away := stat.Home*progress, stat.Away*progress
+		if stat.Share {
+			home = 50 + (stat.Home-50)*math.Sqrt(progress)
+			if !finished {
+				// Deterministic wobble of -2..+2 points, changing every minute
+				home += float64((int(t)*7+i*3)%5 - 2)
+			}
+			home = math.Max(0, math.Min(100, math.Round(home)))
+			away = 100 - home
+		}
+		stats = append(stats, api.MatchStatistic{
+			Key:       stat.Key,
+			Label:     stat.Label,
+			HomeValue: formatStat(home, stat.Home),
+			AwayValue: formatStat(away, stat.Away),
+		})
+	}
+	return stats
+}
+
+// formatStat formats a stat value like its final value: whole numbers for
+// counts, one decimal for values such as expected goals.
+func formatStat(value, final float64) string {
+	if final == math.Trunc(final) {
+		return fmt.Sprintf("%d", int(math.Round(value)))
+	}
+	return fmt.Sprintf("%.1f", value)
+}
+
+// players numbers a list of names from first.
+func players(names []string, first int) []api.PlayerInfo {
+	if len(names) == 0 {
+		return nil
+	}
+	infos := make([]api.PlayerInfo, len(names))
+	for i, name := range names {
+		infos[i] = api.PlayerInfo{Name: name, Number: first + i}
+	}
+	return infos
+}
+
+// stringPtr returns a pointer to s.
+func stringPtr(s string) *string {
+	return &s
+}
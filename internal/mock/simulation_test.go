@@ -0,0 +1,206 @@
+package mock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+var kickoff = time.Date(2025, 5, 10, 15, 0, 0, 0, time.UTC)
+
+// at returns the real time timeline minute t is reached at speed 1.
+func at(t float64) time.Time {
+	return kickoff.Add(time.Duration(t * float64(time.Minute)))
+}
+
+// cupTie plays extra time, so stat counts grow over 120 minutes. It is drawn 1-1 after extra time and won 3-1 on penalties by the home side.
+//
+// Timeline (minutes since kickoff): first half 0-47, HT 47-62, second half
+// 62-110, ET break 110-115, extra time 115-131 and 132-149, break 149-154,
+// shootout 154-159.
+func cupTie() *Scenario {
+	return &Scenario{
+		ID:        9,
+		Home:      ScenarioTeam{Name: "Real Madrid"},
+		Away:      ScenarioTeam{Name: "Bayern"},
+		Stoppage:  Stoppage{FirstHalf: 2, SecondHalf: 3, ExtraFirst: 1, ExtraSecond: 2},
+		ExtraTime: true,
+		Events: []ScenarioEvent{
+			{Minute: 30, Type: EventGoal, Team: "home", Player: "Mbappé"},
+			{Minute: 90, Added: 2, Type: EventGoal, Team: "away", Player: "Kane"},
+		},
+		Shootout: []ScenarioKick{
+			{Team: "home", Player: "Mbappé", Scored: true},
+			{Team: "away", Player: "Kane", Scored: false},
+			{Team: "home", Player: "Bellingham", Scored: true},
+			{Team: "away", Player: "Musiala", Scored: true},
+			{Team: "home", Player: "Vinícius", Scored: true},
+		},
+		Stats: []ScenarioStat{
+			{Key: "shots", Label: "Shots", Home: 12, Away: 8},
+			{Key: "possession", Label: "Possession", Home: 60, Away: 40, Share: true},
+		},
+	}
+}
+
+func TestPosition(t *testing.T) {
+	s := NewSimulation(cupTie(), kickoff, 1)
+
+	tests := []struct {
+		minute, added int
+		want          float64
+	}{
+		{1, 0, 0},
+		{45, 0, 44},
+		{45, 2, 46},
+		{46, 0, 62},
+		{90, 3, 109},
+		{91, 0, 115},
+		{105, 1, 130},
+		{106, 0, 132},
+		{120, 2, 148},
+		{121, 0, s.end}, // Not played
+	}
+	for _, tt := range tests {
+		if got := s.position(tt.minute, tt.added); got != tt.want {
+			t.Errorf("position(%d, %d) = %v, want %v", tt.minute, tt.added, got, tt.want)
+		}
+	}
+	if s.end != 159 {
+		t.Errorf("end = %v, want 159 (five kicks after the 154th minute)", s.end)
+	}
+}
+
+func TestStatusAtPeriodBoundaries(t *testing.T) {
+	s := NewSimulation(cupTie(), kickoff, 1)
+
+	tests := []struct {
+		t      float64
+		status api.MatchStatus
+		clock  string
+	}{
+		{-1, api.MatchStatusNotStarted, ""},
+		{0, api.MatchStatusLive, "1'"},
+		{44.9, api.MatchStatusLive, "45'"},
+		{45, api.MatchStatusLive, "45+1'"},
+		{46.9, api.MatchStatusLive, "45+2'"},
+		{47, api.MatchStatusLive, "HT"},
+		{61.9, api.MatchStatusLive, "HT"},
+		{62, api.MatchStatusLive, "46'"},
+		{107, api.MatchStatusLive, "90+1'"},
+		{109.9, api.MatchStatusLive, "90+3'"},
+		{110, api.MatchStatusLive, "ET"},
+		{115, api.MatchStatusLive, "91'"},
+		{130, api.MatchStatusLive, "105+1'"},
+		{131, api.MatchStatusLive, "ET HT"},
+		{132, api.MatchStatusLive, "106'"},
+		{148, api.MatchStatusLive, "120+2'"},
+		{149, api.MatchStatusLive, "Pen"},
+		{154, api.MatchStatusLive, "Pen"},
+		{159, api.MatchStatusFinished, "Pen"},
+	}
+	for _, tt := range tests {
+		status, clock := s.status(tt.t)
+		got := ""
+		if clock != nil {
+			got = *clock
+		}
+		if status != tt.status || got != tt.clock {
+			t.Errorf("status(%v) = %s %q, want %s %q", tt.t, status, got, tt.status, tt.clock)
+		}
+	}
+}
+
+func TestStartAtJoinsInProgress(t *testing.T) {
+	scenario := cupTie()
+	scenario.StartAt = 100
+	s := NewSimulation(scenario, kickoff, 1)
+	if _, clock := s.status(s.timeline(kickoff)); clock == nil || *clock != "100'" {
+		t.Errorf("clock at start = %v, want 100'", clock)
+	}
+
+	scenario.StartAt = -10
+	s = NewSimulation(scenario, kickoff, 1)
+	if status, _ := s.status(s.timeline(kickoff.Add(9 * time.Minute))); status != api.MatchStatusNotStarted {
+		t.Errorf("status 9 minutes in = %s, want not started for 10 minutes", status)
+	}
+}
+
+func TestDetails(t *testing.T) {
+	s := NewSimulation(cupTie(), kickoff, 1)
+
+	tests := []struct {
+		name               string
+		t                  float64
+		home, away         int
+		halfTime           bool
+		extraTime          bool
+		pensHome, pensAway int // -1 when no shootout has started
+		winner             string
+		events             int
+		shots              string
+		possessionSettled  bool
+	}{
+		{"first goal not yet", 28.9, 0, 0, false, false, -1, -1, "", 0, "3", false},
+		{"first goal", 29, 1, 0, false, false, -1, -1, "", 1, "3", false},
+		{"half time", 47, 1, 0, true, false, -1, -1, "", 1, "5", false},
+		{"stoppage equaliser", 108, 1, 1, true, false, -1, -1, "", 2, "9", false},
+		{"extra time", 115, 1, 1, true, true, -1, -1, "", 2, "9", false},
+		{"second kick", 155, 1, 1, true, true, 1, 0, "", 4, "12", false},
+		{"won on penalties", 159, 1, 1, true, true, 3, 1, "home", 7, "12", true},
+	}
+	for _, tt := range tests {
+		details := s.Details(at(tt.t))
+		if *details.HomeScore != tt.home || *details.AwayScore != tt.away {
+			t.Errorf("%s: score = %d-%d, want %d-%d", tt.name, *details.HomeScore, *details.AwayScore, tt.home, tt.away)
+		}
+		if (details.HalfTimeScore != nil) != tt.halfTime {
+			t.Errorf("%s: half-time score = %v, want set: %v", tt.name, details.HalfTimeScore, tt.halfTime)
+		}
+		if details.ExtraTime != tt.extraTime {
+			t.Errorf("%s: extra time = %v, want %v", tt.name, details.ExtraTime, tt.extraTime)
+		}
+		switch {
+		case tt.pensHome < 0 && details.Penalties != nil:
+			t.Errorf("%s: penalties = %d-%d before the shootout", tt.name, *details.Penalties.Home, *details.Penalties.Away)
+		case tt.pensHome >= 0 && (details.Penalties == nil || *details.Penalties.Home != tt.pensHome || *details.Penalties.Away != tt.pensAway):
+			t.Errorf("%s: penalties = %+v, want %d-%d", tt.name, details.Penalties, tt.pensHome, tt.pensAway)
+		}
+		winner := ""
+		if details.Winner != nil {
+			winner = *details.Winner
+		}
+		if winner != tt.winner {
+			t.Errorf("%s: winner = %q, want %q", tt.name, winner, tt.winner)
+		}
+		if len(details.Events) != tt.events {
+			t.Errorf("%s: %d events, want %d", tt.name, len(details.Events), tt.events)
+		}
+		if shots := details.Statistics[0].HomeValue; shots != tt.shots {
+			t.Errorf("%s: home shots = %s, want %s", tt.name, shots, tt.shots)
+		}
+		if settled := details.Statistics[1].HomeValue == "60"; tt.possessionSettled && !settled {
+			t.Errorf("%s: possession = %s, want the final 60", tt.name, details.Statistics[1].HomeValue)
+		}
+	}
+
+	if details := s.Details(at(-5)); details.Status != api.MatchStatusNotStarted || details.HomeScore != nil || len(details.Events) != 0 {
+		t.Errorf("before kickoff: status %s, score %v, %d events", details.Status, details.HomeScore, len(details.Events))
+	}
+}
+
+func TestDetailsAfterExtraTime(t *testing.T) {
+	scenario := cupTie()
+	scenario.Shootout = nil
+	scenario.Events = append(scenario.Events, ScenarioEvent{Minute: 110, Type: EventGoal, Team: "away", Player: "Musiala"})
+	s := NewSimulation(scenario, kickoff, 1)
+
+	details := s.Details(at(s.end))
+	if details.Status != api.MatchStatusFinished || *details.LiveTime != "AET" || details.MatchDuration != 120 {
+		t.Errorf("status = %s %s, duration %d, want finished AET after 120 minutes", details.Status, *details.LiveTime, details.MatchDuration)
+	}
+	if *details.HomeScore != 1 || *details.AwayScore != 2 || details.Winner == nil || *details.Winner != "away" || details.Penalties != nil {
+		t.Errorf("result = %d-%d, winner %v, penalties %v, want 1-2 to the away side", *details.HomeScore, *details.AwayScore, details.Winner, details.Penalties)
+	}
+}
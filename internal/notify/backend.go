@@ -0,0 +1,140 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Backend types accepted in settings.yaml.
+const (
+	BackendDesktop = "desktop"
+	BackendWebhook = "webhook"
+	BackendNtfy    = "ntfy"
+	BackendGotify  = "gotify"
+	BackendExec    = "exec"
+)
+
+// BackendTypes lists the available backend types, default first.
+var BackendTypes = []string{BackendDesktop, BackendWebhook, BackendNtfy, BackendGotify, BackendExec}
+
+const (
+	// DefaultAttempts is how many times a notification is tried per backend.
+	DefaultAttempts = 3
+	// DefaultTimeout bounds each try.
+	DefaultTimeout = 10 * time.Second
+	// retryDelay is the wait before the first retry; it doubles after each.
+	retryDelay = time.Second
+)
+
+// sender delivers a notification once.
+type sender interface {
+	send(ctx context.Context, n Notification) error
+}
+
+// Backend sends notifications one way (desktop, webhook, ...), retrying
+// failed tries.
+type Backend struct {
+	Name string
+	Type string
+
+	sender   sender
+	attempts int
+	timeout  time.Duration
+	delay    time.Duration // Before the first retry
+}
+
+// NewBackend creates the backend configured in settings.yaml.
+// HTTP backends send their requests through client (http.DefaultClient when nil).
+func NewBackend(cfg data.NotificationBackend, client *http.Client) (*Backend, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var s sender
+	var err error
+	switch cfg.Type {
+	case BackendDesktop:
+		s = NewDesktopNotifier()
+	case BackendWebhook:
+		s, err = newWebhook(cfg, client)
+	case BackendNtfy:
+		s, err = newNtfy(cfg, client)
+	case BackendGotify:
+		s, err = newGotify(cfg, client)
+	case BackendExec:
+		s, err = newExec(cfg)
+	default:
+		err = fmt.Errorf("unknown type %q (available: %v)", cfg.Type, BackendTypes)
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = cfg.Type
+	}
+	if err != nil {
+		return nil, fmt.Errorf("notification backend %q: %w", name, err)
+	}
+
+	b := &Backend{
+		Name:     name,
+		Type:     cfg.Type,
+		sender:   s,
+		attempts: cfg.Attempts,
+		timeout:  cfg.Timeout,
+		delay:    retryDelay,
+	}
+	if b.attempts <= 0 {
+		b.attempts = DefaultAttempts
+	}
+	if b.timeout <= 0 {
+		b.timeout = DefaultTimeout
+	}
+	return b, nil
+}
+
+// Send delivers a notification, retrying with a doubling delay until it
+// succeeds, fails permanently (e.g., a 4xx response) or runs out of attempts.
+func (b *Backend) Send(ctx context.Context, n Notification) error {
+	delay := b.delay
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = b.try(ctx, n)
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) || attempt >= b.attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// try sends once, within the backend's timeout.
+func (b *Backend) try(ctx context.Context, n Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+	return b.sender.send(ctx, n)
+}
+
+// permanentError is a failure retrying won't fix, such as a rejected request.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// send lets the desktop notifier be used as a backend.
+func (n *DesktopNotifier) send(_ context.Context, notification Notification) error {
+	return n.Notify(notification)
+}
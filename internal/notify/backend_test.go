@@ -0,0 +1,268 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// request is a request received by a test server.
+type request struct {
+	path    string
+	headers http.Header
+	body    string
+}
+
+// server records the requests it receives and answers with the given
+// statuses in turn (200 once they run out).
+type server struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []request
+	statuses []int
+}
+
+func newServer(t *testing.T, statuses ...int) *server {
+	t.Helper()
+	s := &server{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.requests = append(s.requests, request{path: r.URL.Path, headers: r.Header, body: string(body)})
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		s.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *server) received() []request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]request(nil), s.requests...)
+}
+
+// newTestBackend creates a backend that retries without waiting.
+func newTestBackend(t *testing.T, cfg data.NotificationBackend) *Backend {
+	t.Helper()
+	b, err := NewBackend(cfg, nil)
+	if err != nil {
+		t.Fatalf("NewBackend: %v", err)
+	}
+	b.delay = time.Millisecond
+	return b
+}
+
+func TestWebhookPayloads(t *testing.T) {
+	n := Sample()
+	tests := []struct {
+		format   string
+		template string
+		check    func(t *testing.T, payload map[string]any)
+	}{
+		{FormatJSON, "", func(t *testing.T, payload map[string]any) {
+			match, _ := payload["match"].(map[string]any)
+			if payload["event"] != "goal" || payload["minute"] != float64(23) || match["home_score"] != float64(1) {
+				t.Errorf("payload = %v, want the goal and match", payload)
+			}
+		}},
+		{FormatSlack, "", func(t *testing.T, payload map[string]any) {
+			if payload["text"] != "*"+n.Title+"*\n"+n.Message {
+				t.Errorf("payload = %v, want the title and message as text", payload)
+			}
+		}},
+		{FormatDiscord, "", func(t *testing.T, payload map[string]any) {
+			if payload["content"] != "**"+n.Title+"**\n"+n.Message {
+				t.Errorf("payload = %v, want the title and message as content", payload)
+			}
+		}},
+		{"", `{"summary": {{json .Message}}, "home": {{json .Match.HomeTeam.Name}}}`, func(t *testing.T, payload map[string]any) {
+			if payload["summary"] != n.Message || payload["home"] != "Arsenal" {
+				t.Errorf("payload = %v, want the template's fields", payload)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format+tt.template, func(t *testing.T) {
+			srv := newServer(t)
+			b := newTestBackend(t, data.NotificationBackend{
+				Type:     BackendWebhook,
+				URL:      srv.URL + "/hook",
+				Format:   tt.format,
+				Template: tt.template,
+				Headers:  map[string]string{"X-Token": "secret"},
+			})
+			if err := b.Send(context.Background(), n); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+
+			got := srv.received()
+			if len(got) != 1 || got[0].path != "/hook" || got[0].headers.Get("X-Token") != "secret" {
+				t.Fatalf("requests = %+v, want one to /hook with the extra header", got)
+			}
+			var payload map[string]any
+			if err := json.Unmarshal([]byte(got[0].body), &payload); err != nil {
+				t.Fatalf("payload %q is not JSON: %v", got[0].body, err)
+			}
+			tt.check(t, payload)
+		})
+	}
+}
+
+func TestPushBackends(t *testing.T) {
+	n := Sample()
+
+	t.Run("ntfy", func(t *testing.T) {
+		srv := newServer(t)
+		b := newTestBackend(t, data.NotificationBackend{Type: BackendNtfy, URL: srv.URL + "/golazo", Token: "tk", Priority: 4})
+		if err := b.Send(context.Background(), n); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+		got := srv.received()[0]
+		title, err := new(mime.WordDecoder).DecodeHeader(got.headers.Get("Title"))
+		if got.path != "/golazo" || got.body != n.Message || err != nil || title != n.Title {
+			t.Errorf("request = %+v (title %q), want the message posted to the topic", got, title)
+		}
+		if got.headers.Get("Priority") != "4" || got.headers.Get("Authorization") != "Bearer tk" {
+			t.Errorf("headers = %v, want the priority and token", got.headers)
+		}
+	})
+
+	t.Run("gotify", func(t *testing.T) {
+		srv := newServer(t)
+		b := newTestBackend(t, data.NotificationBackend{Type: BackendGotify, URL: srv.URL + "/", Token: "app"})
+		if err := b.Send(context.Background(), n); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+		got := srv.received()[0]
+		var message map[string]any
+		_ = json.Unmarshal([]byte(got.body), &message)
+		if got.path != "/message" || got.headers.Get("X-Gotify-Key") != "app" || message["title"] != n.Title || message["message"] != n.Message {
+			t.Errorf("request = %+v, want the message posted with the app token", got)
+		}
+	})
+}
+
+func TestBackendRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		attempts int
+		requests int
+		wantErr  bool
+	}{
+		{"server errors are retried", []int{500, 503}, 3, 3, false},
+		{"rate limits are retried", []int{429}, 3, 2, false},
+		{"gives up after the attempts", []int{500, 500, 500}, 3, 3, true},
+		{"single attempt", []int{500}, 1, 1, true},
+		{"rejected requests are not retried", []int{400}, 3, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(t, tt.statuses...)
+			b := newTestBackend(t, data.NotificationBackend{Type: BackendWebhook, URL: srv.URL, Attempts: tt.attempts})
+			err := b.Send(context.Background(), Sample())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send error = %v, want error %v", err, tt.wantErr)
+			}
+			if n := len(srv.received()); n != tt.requests {
+				t.Errorf("got %d requests, want %d", n, tt.requests)
+			}
+		})
+	}
+}
+
+func TestBackendTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	b := newTestBackend(t, data.NotificationBackend{Type: BackendWebhook, URL: srv.URL, Attempts: 1, Timeout: 50 * time.Millisecond})
+	if err := b.Send(context.Background(), Sample()); err == nil {
+		t.Error("Send succeeded against a server that never answers")
+	}
+}
+
+func TestExecBackend(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	out := filepath.Join(t.TempDir(), "out")
+	b := newTestBackend(t, data.NotificationBackend{
+		Type:    BackendExec,
+		Command: []string{"sh", "-c", `printf '%s|%s|%s-%s|%s' "$GOLAZO_EVENT" "$GOLAZO_HOME_TEAM" "$GOLAZO_HOME_SCORE" "$GOLAZO_AWAY_SCORE" "$GOLAZO_MINUTE" > "$1"`, "hook", out},
+	})
+	if err := b.Send(context.Background(), Sample()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	if string(got) != "goal|Arsenal|1-0|23" {
+		t.Errorf("hook saw %q, want the event in its environment", got)
+	}
+
+	failing := newTestBackend(t, data.NotificationBackend{Type: BackendExec, Command: []string{"sh", "-c", "echo broken >&2; exit 3"}, Attempts: 1})
+	if err := failing.Send(context.Background(), Sample()); err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("Send error = %v, want the command's stderr", err)
+	}
+}
+
+func TestInvalidBackends(t *testing.T) {
+	for _, cfg := range []data.NotificationBackend{
+		{Type: "pager"},
+		{Type: BackendWebhook},
+		{Type: BackendWebhook, URL: "http://example.com", Format: "teams"},
+		{Type: BackendWebhook, URL: "http://example.com", Template: "{{.Missing"},
+		{Type: BackendNtfy},
+		{Type: BackendGotify, URL: "http://example.com"},
+		{Type: BackendExec},
+	} {
+		if _, err := NewBackend(cfg, nil); err == nil {
+			t.Errorf("NewBackend(%+v) succeeded, want an error", cfg)
+		}
+	}
+}
+
+func TestDispatcherSendsToEveryBackend(t *testing.T) {
+	down := newServer(t, 500, 500, 500)
+	up := newServer(t)
+	failing := newTestBackend(t, data.NotificationBackend{Type: BackendWebhook, URL: down.URL})
+	working := newTestBackend(t, data.NotificationBackend{Type: BackendNtfy, URL: up.URL})
+
+	d := NewDispatcher(failing, working)
+	if err := d.Notify(Sample()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	d.Close()
+
+	if n := len(down.received()); n != DefaultAttempts {
+		t.Errorf("failing backend got %d requests, want %d", n, DefaultAttempts)
+	}
+	if n := len(up.received()); n != 1 {
+		t.Errorf("working backend got %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,127 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+// queueSize is how many notifications can wait for a backend before new
+// ones are dropped.
+const queueSize = 32
+
+// Dispatcher sends every notification to each of its backends in the
+// background, so a slow or unreachable backend (retrying, say) holds up
+// neither the caller nor the other backends. Notifications that still fail
+// after the retries are dropped; "golazo notify test" shows why.
+type Dispatcher struct {
+	backends []*Backend
+	queues   []chan Notification
+	wg       sync.WaitGroup
+	close    sync.Once
+}
+
+// NewDispatcher starts sending to the backends.
+func NewDispatcher(backends ...*Backend) *Dispatcher {
+	d := &Dispatcher{backends: backends}
+	for _, b := range backends {
+		queue := make(chan Notification, queueSize)
+		d.queues = append(d.queues, queue)
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for n := range queue {
+				_ = b.Send(context.Background(), n)
+			}
+		}()
+	}
+	return d
+}
+
+// FromSettings creates a dispatcher for the backends in settings.yaml (see
+// Backends).
+func FromSettings(settings data.NotificationSettings, client *http.Client) (*Dispatcher, error) {
+	backends, err := Backends(settings, client)
+	if err != nil {
+		return nil, err
+	}
+	return NewDispatcher(backends...), nil
+}
+
+// Backends creates the backends in settings.yaml, or a desktop backend when
+// none are configured.
+// HTTP backends send their requests through client (http.DefaultClient when nil).
+func Backends(settings data.NotificationSettings, client *http.Client) ([]*Backend, error) {
+	configs := settings.Backends
+	if len(configs) == 0 {
+		configs = []data.NotificationBackend{{Type: BackendDesktop}}
+	}
+
+	backends := make([]*Backend, 0, len(configs))
+	for _, cfg := range configs {
+		b, err := NewBackend(cfg, client)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, b)
+	}
+	return backends, nil
+}
+
+// Notify queues a notification for every backend without waiting for it to
+// be sent. It returns an error naming the backends whose queue was full.
+func (d *Dispatcher) Notify(n Notification) error {
+	var errs []error
+	for i, queue := range d.queues {
+		select {
+		case queue <- n:
+		default:
+			errs = append(errs, fmt.Errorf("%s: queue full, notification dropped", d.backends[i].Name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close waits for the queued notifications to be sent. Notify must not be
+// called afterwards.
+func (d *Dispatcher) Close() {
+	d.close.Do(func() {
+		for _, queue := range d.queues {
+			close(queue)
+		}
+	})
+	d.wg.Wait()
+}
+
+// Sample returns a goal notification for an example match, for testing
+// backends.
+func Sample() Notification {
+	home, away := 1, 0
+	clock := "23'"
+	scorer, assist := "Saka", "Ødegaard"
+	arsenal := api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}
+	details := &api.MatchDetails{Match: api.Match{
+		ID:        1,
+		League:    api.League{ID: 47, Name: "Premier League", Country: "England"},
+		HomeTeam:  arsenal,
+		AwayTeam:  api.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"},
+		Status:    api.MatchStatusLive,
+		HomeScore: &home,
+		AwayScore: &away,
+		LiveTime:  &clock,
+	}}
+	goal := matchdiff.ScoreChanged{
+		Team: arsenal,
+		Home: home,
+		Away: away,
+		Goal: &api.MatchEvent{Minute: 23, Type: "goal", Team: arsenal, Player: &scorer, Assist: &assist},
+	}
+	rules := []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}}}
+	return Notifications(rules, details, []matchdiff.Change{goal})[0]
+}
@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// execHook runs a user command for each notification, with the match and
+// event in GOLAZO_* environment variables (see Env).
+type execHook struct {
+	command []string
+}
+
+func newExec(cfg data.NotificationBackend) (*execHook, error) {
+	if len(cfg.Command) == 0 || cfg.Command[0] == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	return &execHook{command: cfg.Command}, nil
+}
+
+func (h *execHook) send(ctx context.Context, n Notification) error {
+	cmd := exec.CommandContext(ctx, h.command[0], h.command[1:]...)
+	cmd.Env = append(os.Environ(), Env(n)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return fmt.Errorf("%s: %w: %s", h.command[0], err, detail)
+		}
+		return fmt.Errorf("%s: %w", h.command[0], err)
+	}
+	return nil
+}
+
+// Env returns the environment variables describing a notification to exec
+// hooks, as KEY=value pairs.
+func Env(n Notification) []string {
+	score := func(s *int) string {
+		if s == nil {
+			return ""
+		}
+		return strconv.Itoa(*s)
+	}
+	clock := ""
+	if n.Match.LiveTime != nil {
+		clock = *n.Match.LiveTime
+	}
+
+	return []string{
+		"GOLAZO_EVENT=" + string(n.Event),
+		"GOLAZO_TITLE=" + n.Title,
+		"GOLAZO_MESSAGE=" + n.Message,
+		"GOLAZO_MINUTE=" + strconv.Itoa(n.Minute),
+		"GOLAZO_MATCH_ID=" + strconv.Itoa(n.Match.ID),
+		"GOLAZO_MATCH_STATUS=" + string(n.Match.Status),
+		"GOLAZO_MATCH_CLOCK=" + clock,
+		"GOLAZO_LEAGUE_ID=" + strconv.Itoa(n.Match.League.ID),
+		"GOLAZO_LEAGUE=" + n.Match.League.Name,
+		"GOLAZO_HOME_TEAM=" + n.Match.HomeTeam.Name,
+		"GOLAZO_AWAY_TEAM=" + n.Match.AwayTeam.Name,
+		"GOLAZO_HOME_SCORE=" + score(n.Match.HomeScore),
+		"GOLAZO_AWAY_SCORE=" + score(n.Match.AwayScore),
+	}
+}
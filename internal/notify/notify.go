@@ -1,5 +1,9 @@
-// Package notify provides desktop notification functionality for match events.
-// Currently supports macOS, Linux, and Windows via the beeep library.
+// Package notify sends notifications for match events: desktop notifications
+// (macOS, Linux and Windows via the beeep library), webhooks, ntfy/gotify
+// pushes and user commands (see Backend).
+//
+// Which events are notified is decided by the rules in settings.yaml
+// (see Notifications).
 package notify
 
 import (
@@ -10,7 +14,6 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/assets"
-	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/gen2brain/beeep"
 )
@@ -42,11 +45,24 @@ func getIconPath() string {
 	return iconPath
 }
 
-// Notifier defines the interface for sending desktop notifications.
+// Notification is a match event to tell the user about.
+type Notification struct {
+	Event   data.NotificationEvent `json:"event"`
+	Match   api.Match              `json:"match"`  // Teams, league and the score after the event
+	Minute  int                    `json:"minute"` // Match minute of the event, 0 when it has none (e.g., line-ups)
+	Title   string                 `json:"title"`
+	Message string                 `json:"message"`
+
+	// Key identifies the event within the match ("goal:1234", "full_time"),
+	// to notify it once.
+	Key string `json:"key"`
+}
+
+// Notifier defines the interface for sending notifications.
 // This allows for easy mocking in tests and potential future implementations.
 type Notifier interface {
-	// Goal sends a notification for a new goal event.
-	Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+	// Notify sends a notification.
+	Notify(n Notification) error
 }
 
 // DesktopNotifier implements Notifier using native desktop notifications.
@@ -72,10 +88,9 @@ func (n *DesktopNotifier) Enabled() bool {
 	return n.enabled
 }
 
-// Goal sends a desktop notification for a new goal event.
-// Includes scorer name, minute, team, and current score.
+// Notify sends a desktop notification.
 // Always plays a terminal beep as a fallback notification.
-func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+func (n *DesktopNotifier) Notify(notification Notification) error {
 	if !n.enabled {
 		return nil
 	}
@@ -84,14 +99,10 @@ func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team
 	// This works even when the TUI is active
 	_, _ = os.Stderr.WriteString("\a")
 
-	// Build notification content
-	title := constants.NotificationTitleGoal
-	message := formatGoalMessage(event, homeTeam, awayTeam, homeScore, awayScore)
-
 	// Send notification via beeep (cross-platform)
 	// Errors are ignored - OS notification is best-effort, beep already played
 	// Icon shows golazo logo on Linux/Windows; macOS shows terminal app icon
-	_ = beeep.Notify(title, message, getIconPath())
+	_ = beeep.Notify(notification.Title, notification.Message, getIconPath())
 
 	return nil
 }
@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// ntfy publishes notifications to an ntfy topic (https://ntfy.sh).
+type ntfy struct {
+	client   *http.Client
+	url      string // Topic URL, e.g., https://ntfy.sh/my-golazo
+	token    string
+	priority int
+	headers  map[string]string
+}
+
+func newNtfy(cfg data.NotificationBackend, client *http.Client) (*ntfy, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("url is required (the topic URL, e.g., https://ntfy.sh/my-golazo)")
+	}
+	return &ntfy{client: client, url: cfg.URL, token: cfg.Token, priority: cfg.Priority, headers: cfg.Headers}, nil
+}
+
+func (p *ntfy) send(ctx context.Context, n Notification) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, strings.NewReader(n.Message))
+	if err != nil {
+		return &permanentError{fmt.Errorf("create request: %w", err)}
+	}
+	// Header values must be ASCII: titles with emoji are RFC 2047 encoded
+	req.Header.Set("Title", mime.QEncoding.Encode("utf-8", n.Title))
+	req.Header.Set("Tags", "soccer")
+	if p.priority > 0 {
+		req.Header.Set("Priority", strconv.Itoa(p.priority))
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+	return do(p.client, req)
+}
+
+// gotify pushes notifications to a Gotify server (https://gotify.net).
+type gotify struct {
+	client   *http.Client
+	url      string // Server URL, e.g., https://gotify.example.com
+	token    string // Application token
+	priority int
+	headers  map[string]string
+}
+
+func newGotify(cfg data.NotificationBackend, client *http.Client) (*gotify, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("url is required (the server URL)")
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("token is required (an application token)")
+	}
+	return &gotify{client: client, url: strings.TrimSuffix(cfg.URL, "/"), token: cfg.Token, priority: cfg.Priority, headers: cfg.Headers}, nil
+}
+
+func (p *gotify) send(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(map[string]any{
+		"title":    n.Title,
+		"message":  n.Message,
+		"priority": p.priority,
+	})
+	if err != nil {
+		return &permanentError{fmt.Errorf("encode message: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/message", bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{fmt.Errorf("create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", p.token)
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+	return do(p.client, req)
+}
@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+// Notifications returns the notifications for the changes of a match poll
+// that the rules turn on, in the order of the changes. An event is notified
+// once even if several rules cover it.
+func Notifications(rules []data.NotificationRule, details *api.MatchDetails, changes []matchdiff.Change) []Notification {
+	if details == nil {
+		return nil
+	}
+
+	var out []Notification
+	for _, change := range changes {
+		event, ok := EventOf(change)
+		if !ok || !ruleMatches(rules, event, details.Match) {
+			continue
+		}
+		out = append(out, build(event, details, change))
+	}
+	return out
+}
+
+// EventOf returns the notification event a change is reported as. Changes
+// that have no event of their own (substitutions, edits, the second half
+// starting) return false.
+func EventOf(change matchdiff.Change) (data.NotificationEvent, bool) {
+	switch c := change.(type) {
+	case matchdiff.ScoreChanged:
+		return data.NotifyGoal, true
+	case matchdiff.GoalDisallowed:
+		return data.NotifyVAROverturn, true
+	case matchdiff.StatusChanged:
+		switch {
+		case c.To == matchdiff.PhaseFinished:
+			return data.NotifyFullTime, true
+		case c.From == matchdiff.PhaseNotStarted:
+			return data.NotifyKickoff, true
+		case c.To == matchdiff.PhaseHalfTime:
+			return data.NotifyHalfTime, true
+		}
+	case matchdiff.CardShown:
+		if c.Red {
+			return data.NotifyRedCard, true
+		}
+	case matchdiff.PenaltyAwarded:
+		return data.NotifyPenaltyAwarded, true
+	case matchdiff.LineupAnnounced:
+		return data.NotifyLineupAnnounced, true
+	}
+	return "", false
+}
+
+// ruleMatches reports whether any enabled rule turns on event for match.
+func ruleMatches(rules []data.NotificationRule, event data.NotificationEvent, match api.Match) bool {
+	home := data.TeamRef{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name, ShortName: match.HomeTeam.ShortName}
+	away := data.TeamRef{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name, ShortName: match.AwayTeam.ShortName}
+	for _, rule := range rules {
+		if !rule.Disabled && rule.Has(event) && rule.Covers(match.ID, match.League.ID, home, away) {
+			return true
+		}
+	}
+	return false
+}
+
+// build creates the notification for a change.
+func build(event data.NotificationEvent, details *api.MatchDetails, change matchdiff.Change) Notification {
+	n := Notification{Event: event, Match: details.Match, Key: string(event)}
+	n.Minute, _ = matchdiff.ClockMinute(details.Match)
+
+	switch c := change.(type) {
+	case matchdiff.ScoreChanged:
+		n.Match.HomeScore, n.Match.AwayScore = &c.Home, &c.Away
+		// The score can move before the goal event is published: no scorer yet
+		goal := api.MatchEvent{Type: "goal", Team: c.Team, Minute: n.Minute}
+		n.Key = fmt.Sprintf("%s:%d-%d", event, c.Home, c.Away)
+		if c.Goal != nil {
+			goal = *c.Goal
+			n.Minute = goal.Minute
+			n.Key = eventKey(event, goal)
+		}
+		n.Title = constants.NotificationTitleGoal
+		n.Message = formatGoalMessage(goal, details.HomeTeam, details.AwayTeam, c.Home, c.Away)
+
+	case matchdiff.GoalDisallowed:
+		n.Match.HomeScore, n.Match.AwayScore = &c.Home, &c.Away
+		n.Title = constants.NotificationTitleVAR
+		player := "Goal"
+		n.Key = fmt.Sprintf("%s:%d-%d:%d", event, c.Home, c.Away, n.Minute)
+		if c.Goal != nil {
+			n.Minute = c.Goal.Minute
+			player = playerName(*c.Goal)
+			n.Key = eventKey(event, *c.Goal)
+		}
+		n.Message = fmt.Sprintf("%s %d' [%s]\n%s", player, n.Minute, teamName(c.Team), scoreLine(n.Match))
+
+	case matchdiff.StatusChanged:
+		switch event {
+		case data.NotifyKickoff:
+			n.Title = constants.NotificationTitleKickoff
+			n.Message = fmt.Sprintf("%s vs %s", details.HomeTeam.ShortName, details.AwayTeam.ShortName)
+		case data.NotifyHalfTime:
+			n.Title = constants.NotificationTitleHalfTime
+			n.Minute = 45
+			n.Message = scoreLine(n.Match)
+		default:
+			n.Title = constants.NotificationTitleFullTime
+			n.Message = scoreLine(n.Match)
+		}
+
+	case matchdiff.CardShown:
+		n.Minute = c.Card.Minute
+		n.Key = eventKey(event, c.Card)
+		n.Title = constants.NotificationTitleRedCard
+		n.Message = fmt.Sprintf("%s %d' [%s]\n%s", playerName(c.Card), c.Card.Minute, teamName(c.Card.Team), scoreLine(n.Match))
+
+	case matchdiff.PenaltyAwarded:
+		n.Minute = c.Penalty.Minute
+		n.Key = eventKey(event, c.Penalty)
+		n.Title = constants.NotificationTitlePenalty
+		outcome := "missed"
+		if c.Scored {
+			outcome = "scored"
+		}
+		n.Message = fmt.Sprintf("%s %s %d' [%s]\n%s", playerName(c.Penalty), outcome, c.Penalty.Minute, teamName(c.Penalty.Team), scoreLine(n.Match))
+
+	case matchdiff.LineupAnnounced:
+		n.Minute = 0
+		n.Title = constants.NotificationTitleLineups
+		// Formations are left out when the provider has none
+		line := fmt.Sprintf("%s %s vs %s %s", details.HomeTeam.ShortName, c.HomeFormation, c.AwayFormation, details.AwayTeam.ShortName)
+		n.Message = strings.Join(strings.Fields(line), " ")
+	}
+	return n
+}
+
+// eventKey identifies a match event by its ID, or by minute and player for
+// providers without event IDs.
+func eventKey(event data.NotificationEvent, e api.MatchEvent) string {
+	if e.ID != 0 {
+		return fmt.Sprintf("%s:%d", event, e.ID)
+	}
+	return fmt.Sprintf("%s:%d:%s", event, e.Minute, playerName(e))
+}
+
+// scoreLine formats the score of a match: "Home 2 - 1 Away".
+func scoreLine(match api.Match) string {
+	home, away := 0, 0
+	if match.HomeScore != nil {
+		home = *match.HomeScore
+	}
+	if match.AwayScore != nil {
+		away = *match.AwayScore
+	}
+	return fmt.Sprintf("%s %d - %d %s", match.HomeTeam.ShortName, home, away, match.AwayTeam.ShortName)
+}
+
+func playerName(event api.MatchEvent) string {
+	if event.Player != nil && *event.Player != "" {
+		return *event.Player
+	}
+	return "Unknown"
+}
+
+func teamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
@@ -0,0 +1,117 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+func testMatch() *api.MatchDetails {
+	home, away := 1, 0
+	clock := "63'"
+	return &api.MatchDetails{Match: api.Match{
+		ID:        100,
+		League:    api.League{ID: 47, Name: "Premier League"},
+		HomeTeam:  api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"},
+		AwayTeam:  api.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"},
+		Status:    api.MatchStatusLive,
+		HomeScore: &home,
+		AwayScore: &away,
+		LiveTime:  &clock,
+	}}
+}
+
+func TestNotificationsFollowRules(t *testing.T) {
+	details := testMatch()
+	player := "Saka"
+	goal := matchdiff.ScoreChanged{
+		Team: details.HomeTeam, Home: 1, Away: 0,
+		Goal: &api.MatchEvent{Minute: 61, Type: "goal", Team: details.HomeTeam, Player: &player},
+	}
+	red := matchdiff.CardShown{Card: api.MatchEvent{Minute: 63, Type: "card", Team: details.AwayTeam, Player: &player}, Red: true}
+	changes := []matchdiff.Change{goal, red, matchdiff.SubstitutionMade{}}
+
+	tests := []struct {
+		name  string
+		rules []data.NotificationRule
+		want  []data.NotificationEvent
+	}{
+		{"default rules", data.DefaultNotificationRules(), []data.NotificationEvent{data.NotifyGoal}},
+		{"no rules", nil, nil},
+		{"league", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyRedCard}, Leagues: []int{47}}}, []data.NotificationEvent{data.NotifyRedCard}},
+		{"other league", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyRedCard}, Leagues: []int{87}}}, nil},
+		{"team by name", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}, Teams: []string{"chelsea"}}}, []data.NotificationEvent{data.NotifyGoal}},
+		{"team by ID", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}, Teams: []string{"9825"}}}, []data.NotificationEvent{data.NotifyGoal}},
+		{"other team", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}, Teams: []string{"Everton"}}}, nil},
+		{"match", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}, Matches: []int{100}}}, []data.NotificationEvent{data.NotifyGoal}},
+		{"league and other team", []data.NotificationRule{{Events: []data.NotificationEvent{data.NotifyGoal}, Leagues: []int{47}, Teams: []string{"Everton"}}}, nil},
+		{"disabled", []data.NotificationRule{{Disabled: true, Events: []data.NotificationEvent{data.NotifyGoal}}}, nil},
+		{"overlapping rules", []data.NotificationRule{
+			{Events: []data.NotificationEvent{data.NotifyGoal}},
+			{Events: []data.NotificationEvent{data.NotifyGoal, data.NotifyRedCard}, Teams: []string{"Arsenal"}},
+		}, []data.NotificationEvent{data.NotifyGoal, data.NotifyRedCard}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []data.NotificationEvent
+			for _, n := range Notifications(tt.rules, details, changes) {
+				got = append(got, n.Event)
+			}
+			if strings.Join(eventNames(got), ",") != strings.Join(eventNames(tt.want), ",") {
+				t.Errorf("notified %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationContent(t *testing.T) {
+	details := testMatch()
+	player := "Palmer"
+	all := []data.NotificationRule{{Events: data.NotificationEvents}}
+
+	tests := []struct {
+		name    string
+		change  matchdiff.Change
+		event   data.NotificationEvent
+		minute  int
+		message string
+	}{
+		{"goal without event", matchdiff.ScoreChanged{Team: details.HomeTeam, Home: 2, Away: 0}, data.NotifyGoal, 63, "Unknown 63' [Arsenal]\nArsenal 2 - 0 Chelsea"},
+		{"kickoff", matchdiff.StatusChanged{From: matchdiff.PhaseNotStarted, To: matchdiff.PhaseFirstHalf}, data.NotifyKickoff, 63, "Arsenal vs Chelsea"},
+		{"half-time", matchdiff.StatusChanged{From: matchdiff.PhaseFirstHalf, To: matchdiff.PhaseHalfTime}, data.NotifyHalfTime, 45, "Arsenal 1 - 0 Chelsea"},
+		{"full-time", matchdiff.StatusChanged{From: matchdiff.PhaseSecondHalf, To: matchdiff.PhaseFinished}, data.NotifyFullTime, 63, "Arsenal 1 - 0 Chelsea"},
+		{"penalty missed", matchdiff.PenaltyAwarded{Penalty: api.MatchEvent{Minute: 58, Team: details.AwayTeam, Player: &player}}, data.NotifyPenaltyAwarded, 58, "Palmer missed 58' [Chelsea]\nArsenal 1 - 0 Chelsea"},
+		{"VAR", matchdiff.GoalDisallowed{Team: details.AwayTeam, Home: 1, Away: 0, Goal: &api.MatchEvent{Minute: 60, Player: &player}}, data.NotifyVAROverturn, 60, "Palmer 60' [Chelsea]\nArsenal 1 - 0 Chelsea"},
+		{"line-ups", matchdiff.LineupAnnounced{HomeFormation: "4-3-3"}, data.NotifyLineupAnnounced, 0, "Arsenal 4-3-3 vs Chelsea"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Notifications(all, details, []matchdiff.Change{tt.change})
+			if len(got) != 1 {
+				t.Fatalf("got %d notifications, want 1", len(got))
+			}
+			n := got[0]
+			if n.Event != tt.event || n.Minute != tt.minute || n.Message != tt.message || n.Title == "" {
+				t.Errorf("notification = %+v, want %s at %d' with %q", n, tt.event, tt.minute, tt.message)
+			}
+		})
+	}
+}
+
+func TestSecondHalfIsNotNotified(t *testing.T) {
+	change := matchdiff.StatusChanged{From: matchdiff.PhaseHalfTime, To: matchdiff.PhaseSecondHalf}
+	if event, ok := EventOf(change); ok {
+		t.Errorf("second half start notified as %s", event)
+	}
+}
+
+func eventNames(events []data.NotificationEvent) []string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = string(e)
+	}
+	return names
+}
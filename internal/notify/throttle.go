@@ -0,0 +1,226 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// EventDigest is the event of a digest: notifications held back by the rate
+// limit, sent together.
+const EventDigest data.NotificationEvent = "digest"
+
+// digestLines is how many notifications a digest lists before "and N more".
+const digestLines = 8
+
+// Throttle sits in front of a notifier and keeps a busy evening from
+// flooding it:
+//   - events already notified (see data.NotifiedLog) are skipped, so
+//     restarts and other instances don't repeat them
+//   - nothing is sent during quiet hours
+//   - past the per-match or global rate limit, notifications are held and
+//     sent as one digest ("3 goals in 2 matches") a window later
+//
+// It is safe for concurrent use.
+type Throttle struct {
+	next  Notifier
+	log   *data.NotifiedLog // nil to notify repeats
+	quiet data.QuietHours
+	limit data.RateLimit
+	now   func() time.Time
+
+	mu      sync.Mutex
+	sent    []sentAt // Notifications sent within the window, oldest first
+	pending []Notification
+	timer   *time.Timer
+}
+
+type sentAt struct {
+	match int
+	at    time.Time
+}
+
+// NewThrottle creates a throttle with the quiet hours and rate limit from
+// settings, recording notified events in log.
+func NewThrottle(next Notifier, settings data.NotificationSettings, log *data.NotifiedLog) *Throttle {
+	return &Throttle{
+		next:  next,
+		log:   log,
+		quiet: settings.QuietHours,
+		limit: settings.RateLimit.WithDefaults(),
+		now:   data.Now,
+	}
+}
+
+// Notify sends a notification now, holds it for the next digest, or drops
+// it as a repeat or during quiet hours.
+func (t *Throttle) Notify(n Notification) error {
+	if t.log != nil && n.Key != "" {
+		// Recorded even if dropped below: it isn't worth sending later
+		if added, _ := t.log.Add(n.Match.ID, n.Key); !added {
+			return nil
+		}
+	}
+
+	now := t.now()
+	if t.quiet.Contains(now) {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.expire(now)
+	if !t.allowed(n.Match.ID) {
+		t.hold(n)
+		return nil
+	}
+	t.sent = append(t.sent, sentAt{match: n.Match.ID, at: now})
+	return t.next.Notify(n)
+}
+
+// Flush sends the held notifications now, as a digest when there are
+// several.
+func (t *Throttle) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	return t.flush()
+}
+
+// flush sends the held notifications. The caller holds t.mu.
+func (t *Throttle) flush() error {
+	pending := t.pending
+	t.pending = nil
+	now := t.now()
+	if len(pending) == 0 || t.quiet.Contains(now) {
+		return nil
+	}
+
+	t.expire(now)
+	n := Digest(pending)
+	if len(pending) == 1 {
+		n = pending[0]
+	}
+	t.sent = append(t.sent, sentAt{match: n.Match.ID, at: now})
+	return t.next.Notify(n)
+}
+
+// hold keeps a notification for the digest sent a window after the first
+// one held. The caller holds t.mu.
+func (t *Throttle) hold(n Notification) {
+	t.pending = append(t.pending, n)
+	if t.timer == nil {
+		t.timer = time.AfterFunc(t.limit.Window, func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.timer = nil
+			_ = t.flush()
+		})
+	}
+}
+
+// allowed reports whether a notification for a match fits the rate limit.
+// The caller holds t.mu.
+func (t *Throttle) allowed(match int) bool {
+	if t.limit.Global > 0 && len(t.sent) >= t.limit.Global {
+		return false
+	}
+	if t.limit.PerMatch <= 0 {
+		return true
+	}
+	count := 0
+	for _, s := range t.sent {
+		if s.match == match {
+			count++
+		}
+	}
+	return count < t.limit.PerMatch
+}
+
+// expire forgets notifications sent before the window. The caller holds t.mu.
+func (t *Throttle) expire(now time.Time) {
+	keep := 0
+	for keep < len(t.sent) && now.Sub(t.sent[keep].at) >= t.limit.Window {
+		keep++
+	}
+	t.sent = t.sent[keep:]
+}
+
+// Digest combines notifications into one, titled with what happened
+// ("3 goals, 1 red card in 2 matches") and listing each on its own line.
+func Digest(notifications []Notification) Notification {
+	counts := make(map[data.NotificationEvent]int)
+	var order []data.NotificationEvent
+	matches := make(map[int]bool)
+	var lines []string
+	for i, n := range notifications {
+		if counts[n.Event] == 0 {
+			order = append(order, n.Event)
+		}
+		counts[n.Event]++
+		matches[n.Match.ID] = true
+		if i < digestLines {
+			line := capitalize(noun(n.Event, 1))
+			if n.Minute > 0 {
+				line += fmt.Sprintf(" %d'", n.Minute)
+			}
+			lines = append(lines, line+" · "+scoreLine(n.Match))
+		}
+	}
+	if extra := len(notifications) - digestLines; extra > 0 {
+		lines = append(lines, fmt.Sprintf("and %d more", extra))
+	}
+
+	parts := make([]string, len(order))
+	for i, event := range order {
+		parts[i] = fmt.Sprintf("%d %s", counts[event], noun(event, counts[event]))
+	}
+	return Notification{
+		Event:   EventDigest,
+		Title:   fmt.Sprintf("%s in %d %s", strings.Join(parts, ", "), len(matches), plural(len(matches), "match", "matches")),
+		Message: strings.Join(lines, "\n"),
+	}
+}
+
+// noun names count events of a kind ("goal", "red cards").
+func noun(event data.NotificationEvent, count int) string {
+	switch event {
+	case data.NotifyGoal:
+		return plural(count, "goal", "goals")
+	case data.NotifyKickoff:
+		return plural(count, "kick-off", "kick-offs")
+	case data.NotifyHalfTime:
+		return plural(count, "half-time", "half-times")
+	case data.NotifyFullTime:
+		return plural(count, "full-time", "full-times")
+	case data.NotifyRedCard:
+		return plural(count, "red card", "red cards")
+	case data.NotifyPenaltyAwarded:
+		return plural(count, "penalty", "penalties")
+	case data.NotifyVAROverturn:
+		return plural(count, "VAR overturn", "VAR overturns")
+	case data.NotifyLineupAnnounced:
+		return plural(count, "line-up", "line-ups")
+	}
+	return plural(count, "update", "updates")
+}
+
+func plural(count int, one, many string) string {
+	if count == 1 {
+		return one
+	}
+	return many
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,149 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// recorder is a Notifier keeping what it was sent.
+type recorder struct {
+	mu   sync.Mutex
+	sent []Notification
+}
+
+func (r *recorder) Notify(n Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, n)
+	return nil
+}
+
+func (r *recorder) titles() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []string
+	for _, n := range r.sent {
+		out = append(out, n.Title)
+	}
+	return out
+}
+
+// goalIn returns a goal notification for a match.
+func goalIn(match, id int) Notification {
+	home, away := id, 0
+	return Notification{
+		Event:  data.NotifyGoal,
+		Match:  api.Match{ID: match, HomeTeam: api.Team{ShortName: "ARS"}, AwayTeam: api.Team{ShortName: "CHE"}, HomeScore: &home, AwayScore: &away},
+		Minute: 10 * id,
+		Title:  fmt.Sprintf("goal %d-%d", match, id),
+		Key:    fmt.Sprintf("goal:%d", id),
+	}
+}
+
+// newTestThrottle returns a throttle on a clock that only moves when told.
+func newTestThrottle(settings data.NotificationSettings, log *data.NotifiedLog) (*Throttle, *recorder, *time.Time) {
+	rec := &recorder{}
+	th := NewThrottle(rec, settings, log)
+	now := time.Date(2025, 5, 10, 20, 0, 0, 0, time.UTC)
+	th.now = func() time.Time { return now }
+	return th, rec, &now
+}
+
+func TestThrottleSkipsRepeatsAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	log, err := data.LoadNotifiedLog()
+	if err != nil {
+		t.Fatalf("load log: %v", err)
+	}
+	th, rec, _ := newTestThrottle(data.NotificationSettings{}, log)
+	_ = th.Notify(goalIn(1, 1))
+	_ = th.Notify(goalIn(1, 1))
+	if got := rec.titles(); len(got) != 1 {
+		t.Fatalf("sent %v, want the goal once", got)
+	}
+
+	// A restart reads the log back
+	log, _ = data.LoadNotifiedLog()
+	th, rec, _ = newTestThrottle(data.NotificationSettings{}, log)
+	_ = th.Notify(goalIn(1, 1))
+	_ = th.Notify(goalIn(2, 1)) // Same event key, other match
+	if got := rec.titles(); len(got) != 1 || got[0] != "goal 2-1" {
+		t.Errorf("after restart sent %v, want only the other match's goal", got)
+	}
+}
+
+func TestThrottleQuietHours(t *testing.T) {
+	settings := data.NotificationSettings{QuietHours: data.QuietHours{Start: "23:00", End: "07:30"}}
+	th, rec, now := newTestThrottle(settings, data.NewMemoryNotifiedLog())
+
+	for i, clock := range []string{"22:59", "23:00", "03:00", "07:29", "07:30"} {
+		at, _ := time.Parse("15:04", clock)
+		*now = time.Date(2025, 5, 10, at.Hour(), at.Minute(), 0, 0, time.UTC)
+		_ = th.Notify(goalIn(i, 1))
+	}
+	if got := rec.titles(); len(got) != 2 || got[0] != "goal 0-1" || got[1] != "goal 4-1" {
+		t.Errorf("sent %v, want only the goals outside 23:00-07:30", got)
+	}
+}
+
+func TestThrottleRateLimitDigest(t *testing.T) {
+	settings := data.NotificationSettings{RateLimit: data.RateLimit{PerMatch: 2, Global: 3, Window: 10 * time.Minute}}
+	th, rec, now := newTestThrottle(settings, data.NewMemoryNotifiedLog())
+
+	_ = th.Notify(goalIn(1, 1))
+	_ = th.Notify(goalIn(1, 2))
+	_ = th.Notify(goalIn(1, 3)) // Over the per-match limit
+	_ = th.Notify(goalIn(2, 1))
+	_ = th.Notify(goalIn(3, 1)) // Over the global limit
+	red := goalIn(3, 2)
+	red.Event = data.NotifyRedCard
+	_ = th.Notify(red)
+	if got := rec.titles(); len(got) != 3 {
+		t.Fatalf("sent %v before the digest, want 3", got)
+	}
+
+	*now = now.Add(10 * time.Minute)
+	if err := th.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	digest := rec.sent[len(rec.sent)-1]
+	if digest.Event != EventDigest || digest.Title != "2 goals, 1 red card in 2 matches" {
+		t.Errorf("digest = %q (%s), want 2 goals and a red card in 2 matches", digest.Title, digest.Event)
+	}
+	if lines := strings.Split(digest.Message, "\n"); len(lines) != 3 || lines[0] != "Goal 30' · ARS 3 - 0 CHE" {
+		t.Errorf("digest message = %q, want a line per notification", digest.Message)
+	}
+
+	// The window has passed: notifications go straight out again
+	_ = th.Notify(goalIn(1, 4))
+	if got := rec.titles(); len(got) != 5 || got[4] != "goal 1-4" {
+		t.Errorf("after the window sent %v, want the new goal", got)
+	}
+}
+
+func TestThrottleSendsDigestAfterWindow(t *testing.T) {
+	settings := data.NotificationSettings{RateLimit: data.RateLimit{PerMatch: 1, Window: 20 * time.Millisecond}}
+	rec := &recorder{}
+	th := NewThrottle(rec, settings, nil)
+
+	_ = th.Notify(goalIn(1, 1))
+	_ = th.Notify(goalIn(1, 2))
+	_ = th.Notify(goalIn(1, 3))
+
+	deadline := time.Now().Add(time.Second)
+	for len(rec.titles()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := rec.titles(); len(got) != 2 || got[1] != "2 goals in 1 match" {
+		t.Errorf("sent %v, want the first goal then a digest of the others", got)
+	}
+}
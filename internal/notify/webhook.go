@@ -0,0 +1,109 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Webhook payload formats.
+const (
+	FormatJSON    = "json"    // The Notification as JSON
+	FormatSlack   = "slack"   // {"text": ...}, for Slack incoming webhooks
+	FormatDiscord = "discord" // {"content": ...}, for Discord webhooks
+)
+
+// webhook posts notifications as JSON to a URL.
+type webhook struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+	payload *template.Template
+}
+
+// payloadTemplates are the built-in payloads. Templates get the Notification
+// and a json function that quotes a value as JSON.
+var payloadTemplates = map[string]string{
+	FormatJSON:    `{{json .}}`,
+	FormatSlack:   `{"text": {{json (printf "*%s*\n%s" .Title .Message)}}}`,
+	FormatDiscord: `{"content": {{json (printf "**%s**\n%s" .Title .Message)}}}`,
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func newWebhook(cfg data.NotificationBackend, client *http.Client) (*webhook, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+
+	text := cfg.Template
+	if text == "" {
+		format := cfg.Format
+		if format == "" {
+			format = FormatJSON
+		}
+		var ok bool
+		if text, ok = payloadTemplates[format]; !ok {
+			return nil, fmt.Errorf("unknown format %q (available: %s, %s, %s)", format, FormatJSON, FormatSlack, FormatDiscord)
+		}
+	}
+	payload, err := template.New("payload").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	return &webhook{client: client, url: cfg.URL, headers: cfg.Headers, payload: payload}, nil
+}
+
+func (w *webhook) send(ctx context.Context, n Notification) error {
+	var body bytes.Buffer
+	if err := w.payload.Execute(&body, n); err != nil {
+		return &permanentError{fmt.Errorf("render payload: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, &body)
+	if err != nil {
+		return &permanentError{fmt.Errorf("create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+	return do(w.client, req)
+}
+
+// do sends a request and checks the response. Rate limits and server errors
+// are worth retrying; other error responses are permanent.
+func do(client *http.Client, req *http.Request) error {
+	req.Header.Set("User-Agent", "golazo")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	// Services explain rejected requests in the body
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("unexpected status: %d %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return &permanentError{err}
+}
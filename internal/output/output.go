@@ -0,0 +1,137 @@
+// Package output prints the results of golazo's command-line subcommands as
+// aligned text, JSON, CSV or YAML.
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats accepted by --output.
+const (
+	Text = "text"
+	JSON = "json"
+	CSV  = "csv"
+	YAML = "yaml"
+)
+
+// Formats lists the output formats, default first.
+var Formats = []string{Text, JSON, CSV, YAML}
+
+// Table is a command's result. Text and CSV print its rows; JSON and YAML
+// print Value, which keeps the full data (with the keys of its json tags).
+type Table struct {
+	Summary []string // Lines printed above the text table only
+	Headers []string
+	Rows    [][]string
+	Value   any
+}
+
+// Validate returns an error if format isn't one of Formats.
+func Validate(format string) error {
+	for _, f := range Formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output format %q (available: %s)", format, strings.Join(Formats, ", "))
+}
+
+// Write prints a table in format.
+func Write(w io.Writer, format string, t Table) error {
+	switch format {
+	case Text:
+		return writeText(w, t)
+	case CSV:
+		return writeCSV(w, t)
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(t.Value)
+	case YAML:
+		return writeYAML(w, t.Value)
+	}
+	return Validate(format)
+}
+
+// writeText prints the summary, then the rows in columns aligned under the
+// upper-cased headers.
+func writeText(w io.Writer, t Table) error {
+	for _, line := range t.Summary {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	if len(t.Rows) == 0 {
+		return nil
+	}
+	if len(t.Summary) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(t.Headers))
+	for i, h := range t.Headers {
+		headers[i] = strings.ToUpper(h)
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range t.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// writeCSV prints the headers and rows as CSV.
+func writeCSV(w io.Writer, t Table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.Headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// writeYAML prints v as YAML with the same keys and order as its JSON. The
+// JSON is read back as a YAML document (YAML being a superset of JSON), then
+// printed in block style.
+func writeYAML(w io.Writer, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// blockStyle drops the flow style and quoting JSON comes with. The encoder
+// still quotes strings that would read back as another type ("10").
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
@@ -0,0 +1,104 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+type row struct {
+	ID    int    `json:"id"`
+	Team  string `json:"team"`
+	Round string `json:"round,omitempty"`
+	Note  string `json:"note"`
+}
+
+func sample() Table {
+	return Table{
+		Summary: []string{"Premier League"},
+		Headers: []string{"id", "team"},
+		Rows:    [][]string{{"1", "Arsenal"}, {"120", "Brighton, Hove"}},
+		Value: []row{
+			{ID: 1, Team: "Arsenal", Round: "10", Note: "yes"},
+			{ID: 120, Team: "Brighton: Hove", Note: "a\nb"},
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{Text, "Premier League\n\nID   TEAM\n1    Arsenal\n120  Brighton, Hove\n"},
+		{CSV, "id,team\n1,Arsenal\n120,\"Brighton, Hove\"\n"},
+		{JSON, `[
+  {
+    "id": 1,
+    "team": "Arsenal",
+    "round": "10",
+    "note": "yes"
+  },
+  {
+    "id": 120,
+    "team": "Brighton: Hove",
+    "note": "a\nb"
+  }
+]
+`},
+		// Keys keep the json tags and their order; strings that would read
+		// as numbers stay quoted
+		{YAML, `- id: 1
+  team: Arsenal
+  round: "10"
+  note: yes
+- id: 120
+  team: 'Brighton: Hove'
+  note: |-
+    a
+    b
+`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := Write(&buf, tt.format, sample()); err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s output:\n%s\nwant:\n%s", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	empty := Table{Summary: nil, Headers: []string{"id", "team"}, Value: []row{}}
+	want := map[string]string{
+		Text: "",
+		CSV:  "id,team\n",
+		JSON: "[]\n",
+		YAML: "[]\n",
+	}
+	for format, w := range want {
+		var buf bytes.Buffer
+		if err := Write(&buf, format, empty); err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if buf.String() != w {
+			t.Errorf("%s output = %q, want %q", format, buf.String(), w)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, format := range Formats {
+		if err := Validate(format); err != nil {
+			t.Errorf("Validate(%q) = %v", format, err)
+		}
+	}
+	if err := Validate("xml"); err == nil {
+		t.Error("Validate(\"xml\") = nil, want an error")
+	}
+	if err := Write(&bytes.Buffer{}, "xml", sample()); err == nil {
+		t.Error("Write in an unknown format succeeded")
+	}
+}
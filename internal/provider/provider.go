@@ -0,0 +1,66 @@
+// Package provider builds the data provider selected in settings.yaml.
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/footballdata"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/mock"
+)
+
+// Provider names accepted in settings.yaml.
+const (
+	FotMob       = "fotmob"
+	FootballData = "football-data"
+	Mock         = "mock" // Built-in mock data, no network (--mock)
+)
+
+// Names lists the available providers, default first.
+var Names = []string{FotMob, FootballData, Mock}
+
+// New returns the provider named in settings (FotMob when unset).
+// A non-nil transport carries every request (see internal/httprec).
+func New(settings *data.Settings, transport http.RoundTripper) (api.Provider, error) {
+	name := FotMob
+	if settings != nil && settings.Provider != "" {
+		name = settings.Provider
+	}
+
+	switch name {
+	case FotMob:
+		if transport != nil {
+			return fotmob.NewClientWithTransport(transport), nil
+		}
+		return fotmob.NewClient(), nil
+
+	case FootballData:
+		apiKey := settings.FootballData.APIKey
+		if apiKey == "" {
+			apiKey = os.Getenv(footballdata.APIKeyEnv)
+		}
+		if apiKey == "" {
+			return nil, fmt.Errorf("provider %q needs an API key: set football_data.api_key in settings.yaml or %s", name, footballdata.APIKeyEnv)
+		}
+		if transport != nil {
+			return footballdata.NewClientWithTransport(apiKey, transport), nil
+		}
+		return footballdata.NewClient(apiKey), nil
+
+	case Mock:
+		scenarios, err := mock.BuiltinScenarios()
+		if len(settings.Mock.Scenarios) > 0 {
+			scenarios, err = mock.LoadScenarios(settings.Mock.Scenarios)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return mock.NewClientWithScenarios(scenarios, settings.Mock.Speed), nil
+	}
+
+	return nil, fmt.Errorf("unknown provider %q (available: %v)", name, Names)
+}
@@ -0,0 +1,245 @@
+// Package ratelimit spaces out a provider's requests with a token bucket and
+// backs off from hosts answering 429 or 5xx.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config holds configuration for the token-bucket rate limiter.
+type Config struct {
+	Interval    time.Duration // Time to refill one token (steady-state gap between requests)
+	Burst       int           // Maximum number of tokens (requests allowed back-to-back)
+	BaseBackoff time.Duration // First backoff after a 429/5xx from a host
+	MaxBackoff  time.Duration // Upper bound for exponential backoff (Retry-After may exceed it)
+	MaxRetries  int           // Retries per request after a 429/5xx before giving up
+}
+
+// hostBackoff tracks throttling state for a single host.
+type hostBackoff struct {
+	failures int       // Consecutive 429/5xx responses
+	until    time.Time // No requests to this host before this time
+}
+
+// Limiter is a token-bucket rate limiter with per-host exponential backoff.
+// Tokens refill at one per Interval up to Burst. When a host answers 429 or 5xx,
+// requests to it pause for the Retry-After duration (if given) or an
+// exponentially growing backoff. The mutex is never held while waiting.
+type Limiter struct {
+	config Config
+
+	mu         sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+	hosts      map[string]*hostBackoff
+
+	// Counters reported by Stats
+	requests     int
+	throttled    int // 429 responses
+	serverErrors int // 5xx responses
+	retries      int
+	waited       time.Duration
+}
+
+// Stats is a snapshot of the limiter state.
+type Stats struct {
+	Tokens       float64       // Tokens currently available
+	Burst        int           // Bucket size
+	Requests     int           // Requests allowed through
+	Throttled    int           // 429 responses seen
+	ServerErrors int           // 5xx responses seen
+	Retries      int           // Requests retried after a 429/5xx
+	Waited       time.Duration // Total time requests spent waiting for a token or backoff
+	Hosts        []HostStats
+}
+
+// HostStats describes a host that is currently backing off.
+type HostStats struct {
+	Host     string
+	Failures int
+	Until    time.Time
+}
+
+// New creates a new token-bucket rate limiter.
+// The bucket starts full so the first Burst requests go out immediately.
+func New(config Config) *Limiter {
+	if config.Interval < 0 {
+		config.Interval = 0 // Allow no delay if requested
+	}
+	if config.Burst < 1 {
+		config.Burst = 1
+	}
+	return &Limiter{
+		config:     config,
+		tokens:     float64(config.Burst),
+		lastRefill: time.Now(),
+		hosts:      make(map[string]*hostBackoff),
+	}
+}
+
+// Wait blocks until a request to host is allowed: the host is not backing off
+// and a token is available. Returns the context error if ctx is done first.
+func (rl *Limiter) Wait(ctx context.Context, host string) error {
+	start := time.Now()
+	for {
+		delay := rl.reserve(host)
+		if delay <= 0 {
+			rl.mu.Lock()
+			rl.waited += time.Since(start)
+			rl.mu.Unlock()
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if the host may be called now.
+// Otherwise returns how long to wait before trying again.
+func (rl *Limiter) reserve(host string) time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if backoff, ok := rl.hosts[host]; ok && now.Before(backoff.until) {
+		return backoff.until.Sub(now)
+	}
+
+	rl.refill(now)
+	if rl.tokens >= 1 {
+		rl.tokens--
+		rl.requests++
+		return 0
+	}
+
+	// Time until the next token is available
+	return time.Duration((1 - rl.tokens) * float64(rl.config.Interval))
+}
+
+// refill adds tokens for the time elapsed since the last refill (must hold lock).
+func (rl *Limiter) refill(now time.Time) {
+	if rl.config.Interval <= 0 {
+		rl.tokens = float64(rl.config.Burst)
+		rl.lastRefill = now
+		return
+	}
+	elapsed := now.Sub(rl.lastRefill)
+	rl.tokens = math.Min(float64(rl.config.Burst), rl.tokens+float64(elapsed)/float64(rl.config.Interval))
+	rl.lastRefill = now
+}
+
+// Observe records a response from host. 429 and 5xx responses put the host
+// into backoff (honouring Retry-After); any other response clears it.
+// attempt is the number of retries already made for this request.
+// Returns true if the request should be retried.
+func (rl *Limiter) Observe(host string, resp *http.Response, attempt int) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if resp == nil || !isRetryableStatus(resp.StatusCode) {
+		delete(rl.hosts, host)
+		return false
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		rl.throttled++
+	} else {
+		rl.serverErrors++
+	}
+
+	backoff, ok := rl.hosts[host]
+	if !ok {
+		backoff = &hostBackoff{}
+		rl.hosts[host] = backoff
+	}
+	backoff.failures++
+
+	delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	if !ok {
+		delay = rl.config.BaseBackoff << (backoff.failures - 1)
+		if delay > rl.config.MaxBackoff || delay <= 0 {
+			delay = rl.config.MaxBackoff
+		}
+	}
+
+	until := time.Now().Add(delay)
+	if until.After(backoff.until) {
+		backoff.until = until
+	}
+
+	if attempt >= rl.config.MaxRetries {
+		return false
+	}
+	rl.retries++
+	return true
+}
+
+// Stats returns a snapshot of the limiter state.
+func (rl *Limiter) Stats() Stats {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.refill(now)
+
+	stats := Stats{
+		Tokens:       rl.tokens,
+		Burst:        rl.config.Burst,
+		Requests:     rl.requests,
+		Throttled:    rl.throttled,
+		ServerErrors: rl.serverErrors,
+		Retries:      rl.retries,
+		Waited:       rl.waited,
+	}
+	for host, backoff := range rl.hosts {
+		if now.Before(backoff.until) {
+			stats.Hosts = append(stats.Hosts, HostStats{
+				Host:     host,
+				Failures: backoff.failures,
+				Until:    backoff.until,
+			})
+		}
+	}
+	return stats
+}
+
+// BackingOff reports whether any host is currently backing off.
+func (s Stats) BackingOff() bool {
+	return len(s.Hosts) > 0
+}
+
+// isRetryableStatus reports whether a status code means "try again later".
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter parses a Retry-After header (delay in seconds or HTTP date).
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if delay := t.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/watch"
+)
+
+// subscriberBuffer is how many events a slow /events client can fall behind
+// before it misses some.
+const subscriberBuffer = 64
+
+// replaySize is how many recent events are kept for /events clients
+// reconnecting with Last-Event-ID.
+const replaySize = 128
+
+// sequencedEvent is an event with the ID it is streamed with.
+type sequencedEvent struct {
+	watch.Event
+	ID uint64
+}
+
+// hub fans the watcher's events out to the /events streams.
+type hub struct {
+	mu     sync.Mutex
+	next   uint64
+	recent []sequencedEvent // The last replaySize events, oldest first
+	subs   map[chan sequencedEvent]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[chan sequencedEvent]struct{})}
+}
+
+// subscribe returns the kept events published after lastID (none for 0; all
+// of them for an ID from before a restart), a channel receiving every event
+// published from now on, and the function to stop receiving them.
+func (h *hub) subscribe(lastID uint64) ([]sequencedEvent, <-chan sequencedEvent, func()) {
+	ch := make(chan sequencedEvent, subscriberBuffer)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	var missed []sequencedEvent
+	if lastID > 0 {
+		if lastID > h.next {
+			lastID = 0
+		}
+		for _, e := range h.recent {
+			if e.ID > lastID {
+				missed = append(missed, e)
+			}
+		}
+	}
+	h.mu.Unlock()
+
+	return missed, ch, func() {
+		h.mu.Lock()
+		delete(h.subs, ch)
+		h.mu.Unlock()
+	}
+}
+
+// publish sends an event to every subscriber. Subscribers whose buffer is
+// full miss it rather than hold the others up.
+func (h *hub) publish(event watch.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.next++
+	e := sequencedEvent{Event: event, ID: h.next}
+	h.recent = append(h.recent, e)
+	if len(h.recent) > replaySize {
+		h.recent = h.recent[len(h.recent)-replaySize:]
+	}
+	for ch := range h.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// count returns the number of subscribers.
+func (h *hub) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs)
+}
@@ -0,0 +1,438 @@
+// Package server serves a provider's data over HTTP: REST endpoints for
+// matches, live matches, match details, leagues and league tables, and a
+// Server-Sent Events stream of live match changes (see watch.Event).
+//
+// Every client shares the server's provider, so its cache and rate limiter,
+// instead of each requesting FotMob itself. The daemon serves the same API
+// on a Unix socket (see package daemon).
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/watch"
+)
+
+// requestTimeout bounds the provider requests of a REST call.
+const requestTimeout = 30 * time.Second
+
+// keepAliveInterval is how often an idle event stream gets a comment line,
+// so proxies don't close it.
+const keepAliveInterval = 30 * time.Second
+
+// FailedLeaguesHeader lists the leagues (comma-separated IDs) that failed to
+// load when a response holds partial results.
+const FailedLeaguesHeader = "X-Failed-Leagues"
+
+// Options configures a Server.
+type Options struct {
+	// CORSOrigins are the origins allowed to call the API from a browser
+	// ("*" for any). None by default.
+	CORSOrigins []string
+	// PollInterval is the watcher's poll interval while matches are played
+	// (watch.DefaultInterval when 0).
+	PollInterval time.Duration
+	// OnPoll, if set, is called by Run with every poll of the live matches
+	// (the daemon sends notifications from it).
+	OnPoll func(update watch.Update)
+}
+
+// Server is the HTTP API. Run polls the live matches for /events; the REST
+// endpoints work without it.
+type Server struct {
+	client  api.Provider
+	options Options
+	mux     *http.ServeMux
+	watcher *watch.Watcher
+	hub     *hub
+
+	mu       sync.Mutex
+	lastPoll time.Time
+	pollErr  error
+	live     int // Live matches at the last poll
+}
+
+// New creates a server for a provider.
+func New(client api.Provider, options Options) *Server {
+	if options.PollInterval <= 0 {
+		options.PollInterval = watch.DefaultInterval
+	}
+	s := &Server{
+		client:  client,
+		options: options,
+		mux:     http.NewServeMux(),
+		watcher: watch.New(client, options.PollInterval),
+		hub:     newHub(),
+	}
+	s.mux.HandleFunc("GET /healthz", s.handleHealth)
+	s.mux.HandleFunc("GET /matches", s.handleMatches)
+	s.mux.HandleFunc("GET /results", s.handleResults)
+	s.mux.HandleFunc("GET /matches/{id}", s.handleMatch)
+	s.mux.HandleFunc("GET /live", s.handleLive)
+	s.mux.HandleFunc("GET /leagues", s.handleLeagues)
+	s.mux.HandleFunc("GET /leagues/{id}/matches", s.handleLeagueMatches)
+	s.mux.HandleFunc("GET /leagues/{id}/live", s.handleLeagueLive)
+	s.mux.HandleFunc("GET /leagues/{id}/season", s.handleSeason)
+	s.mux.HandleFunc("GET /leagues/{id}/table", s.handleTable)
+	s.mux.HandleFunc("GET /teams", s.handleTeams)
+	s.mux.HandleFunc("GET /events", s.handleEvents)
+	return s
+}
+
+// Handler returns the HTTP handler, with CORS headers for the allowed origins.
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" && s.allowedOrigin(origin) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+			if r.Method == http.MethodOptions {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Last-Event-ID")
+				w.Header().Set("Access-Control-Expose-Headers", FailedLeaguesHeader)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Access-Control-Expose-Headers", FailedLeaguesHeader)
+		}
+		s.mux.ServeHTTP(w, r)
+	})
+}
+
+// allowedOrigin reports whether a browser origin may call the API.
+func (s *Server) allowedOrigin(origin string) bool {
+	for _, allowed := range s.options.CORSOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run polls the live matches until ctx is done, sending their changes to the
+// /events streams.
+func (s *Server) Run(ctx context.Context) {
+	for {
+		update := s.watcher.Poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.lastPoll = time.Now()
+		s.pollErr = update.Err
+		if update.Err == nil {
+			s.live = 0
+			for _, match := range update.Matches {
+				if match.Details.Status == api.MatchStatusLive {
+					s.live++
+				}
+			}
+		}
+		s.mu.Unlock()
+
+		if s.options.OnPoll != nil {
+			s.options.OnPoll(update)
+		}
+		for _, match := range update.Matches {
+			for _, event := range watch.Events(match, data.Now()) {
+				s.hub.publish(event)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(update.Next):
+		}
+	}
+}
+
+// Health is the /healthz response.
+type Health struct {
+	Status       string     `json:"status"`
+	PollInterval string     `json:"poll_interval"`       // While matches are played, e.g., "1m30s"
+	LastPoll     *time.Time `json:"last_poll,omitempty"` // Last live matches poll, if any yet
+	PollError    string     `json:"poll_error,omitempty"`
+	LiveMatches  int        `json:"live_matches"`
+	Subscribers  int        `json:"subscribers"` // Open /events streams
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	h := Health{Status: "ok", PollInterval: s.options.PollInterval.String(), LiveMatches: s.live, Subscribers: s.hub.count()}
+	if !s.lastPoll.IsZero() {
+		lastPoll := s.lastPoll
+		h.LastPoll = &lastPoll
+	}
+	if s.pollErr != nil {
+		h.PollError = s.pollErr.Error()
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, h)
+}
+
+// handleMatches lists the matches of ?date= (YYYY-MM-DD in the configured
+// timezone, today by default).
+func (s *Server) handleMatches(w http.ResponseWriter, r *http.Request) {
+	date, err := data.ParseDate(r.URL.Query().Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	matches, err := s.client.MatchesByDate(ctx, date)
+	writeMatches(w, matches, err)
+}
+
+// handleResults lists the matches of ?date= that can have finished (see
+// api.Client.ResultsByDate).
+func (s *Server) handleResults(w http.ResponseWriter, r *http.Request) {
+	date, err := data.ParseDate(r.URL.Query().Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	matches, err := s.client.ResultsByDate(ctx, date)
+	writeMatches(w, matches, err)
+}
+
+func (s *Server) handleLive(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	matches, err := s.client.LiveMatches(ctx)
+	writeMatches(w, matches, err)
+}
+
+func (s *Server) handleMatch(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	details, err := s.client.MatchDetails(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if details == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("match %d not found", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, details)
+}
+
+func (s *Server) handleLeagues(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	leagues, err := s.client.Leagues(ctx)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if leagues == nil {
+		leagues = []api.League{}
+	}
+	writeJSON(w, http.StatusOK, leagues)
+}
+
+func (s *Server) handleLeagueMatches(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	matches, err := s.client.LeagueMatches(ctx, id)
+	writeMatches(w, matches, err)
+}
+
+func (s *Server) handleLeagueLive(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	matches, err := s.client.LiveMatchesForLeague(ctx, id)
+	writeMatches(w, matches, err)
+}
+
+// handleSeason returns a league season: ?season= names one of its Seasons,
+// the current one by default.
+func (s *Server) handleSeason(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	season, err := s.client.LeagueSeason(ctx, id, r.URL.Query().Get("season"))
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if season == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("league %d season not found", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, season)
+}
+
+func (s *Server) handleTable(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	table, err := s.client.LeagueTable(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if table == nil {
+		table = []api.LeagueTableEntry{}
+	}
+	writeJSON(w, http.StatusOK, table)
+}
+
+// handleTeams looks teams up by name (?q=), for providers that can.
+func (s *Server) handleTeams(w http.ResponseWriter, r *http.Request) {
+	searcher, ok := s.client.(api.TeamSearcher)
+	if !ok {
+		writeError(w, http.StatusNotImplemented, errors.New("team search unsupported by the provider"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	teams, err := searcher.SearchTeams(ctx, r.URL.Query().Get("q"))
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if teams == nil {
+		teams = []api.TeamSearchResult{}
+	}
+	writeJSON(w, http.StatusOK, teams)
+}
+
+// handleEvents streams the changes of live matches as Server-Sent Events,
+// each named after its type with the event as JSON data. ?match= and
+// ?league= keep the events of one match or league. Clients reconnecting with
+// Last-Event-ID first get the recent events they missed.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errors.New("streaming unsupported"))
+		return
+	}
+	matchID, _ := strconv.Atoi(r.URL.Query().Get("match"))
+	leagueID, _ := strconv.Atoi(r.URL.Query().Get("league"))
+	lastID, _ := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	missed, events, unsubscribe := s.hub.subscribe(lastID)
+	defer unsubscribe()
+
+	send := func(e sequencedEvent) {
+		if (matchID != 0 && e.MatchID != matchID) || (leagueID != 0 && e.LeagueID != leagueID) {
+			return
+		}
+		raw, err := json.Marshal(e.Event)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, raw)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	for _, e := range missed {
+		send(e)
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		case e := <-events:
+			send(e)
+			flusher.Flush()
+		}
+	}
+}
+
+// pathID parses the {id} of a path.
+func pathID(r *http.Request) (int, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid ID %q", r.PathValue("id"))
+	}
+	return id, nil
+}
+
+// writeMatches writes a match list. Leagues that failed are listed in
+// FailedLeaguesHeader; nothing loading at all is an error.
+func writeMatches(w http.ResponseWriter, matches []api.Match, err error) {
+	if partial, ok := api.AsPartialResult(err); ok && !partial.AllFailed() {
+		ids := make([]string, 0, len(partial.FailedLeagues()))
+		for _, id := range partial.FailedLeagues() {
+			ids = append(ids, strconv.Itoa(id))
+		}
+		w.Header().Set(FailedLeaguesHeader, strings.Join(ids, ","))
+	} else if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if matches == nil {
+		matches = []api.Match{} // [] rather than null
+	}
+	writeJSON(w, http.StatusOK, matches)
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes {"error": "..."}.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
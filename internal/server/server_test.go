@@ -0,0 +1,216 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/mock"
+	"github.com/0xjuanma/golazo/internal/watch"
+)
+
+// partialProvider answers live matches with a failed league.
+type partialProvider struct {
+	api.Provider
+}
+
+func (p partialProvider) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	matches, _ := p.Provider.LiveMatches(ctx)
+	return matches, &api.PartialResultError{Requests: 3, Failures: []*api.LeagueError{{LeagueID: 87, Kind: api.FailureTimeout, Err: errors.New("timeout")}}}
+}
+
+func get(t *testing.T, handler http.Handler, path string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestEndpoints(t *testing.T) {
+	handler := New(mock.NewClient(), Options{}).Handler()
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/healthz", http.StatusOK},
+		{"/live", http.StatusOK},
+		{"/matches", http.StatusOK},
+		{"/matches?date=2025-05-10", http.StatusOK},
+		{"/matches?date=yesterday", http.StatusBadRequest},
+		{"/matches/1001", http.StatusOK},
+		{"/matches/abc", http.StatusBadRequest},
+		{"/matches/424242", http.StatusNotFound},
+		{"/results?date=2025-05-10", http.StatusOK},
+		{"/leagues", http.StatusOK},
+		{"/leagues/47/matches", http.StatusOK},
+		{"/leagues/47/live", http.StatusOK},
+		{"/leagues/47/season", http.StatusOK},
+		{"/leagues/87/season", http.StatusNotFound},
+		{"/leagues/47/table", http.StatusOK},
+		{"/teams?q=arsenal", http.StatusNotImplemented},
+		{"/nope", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		rec := get(t, handler, tt.path, nil)
+		if rec.Code != tt.status {
+			t.Errorf("GET %s = %d, want %d (%s)", tt.path, rec.Code, tt.status, rec.Body)
+		}
+		if tt.path != "/nope" && !json.Valid(rec.Body.Bytes()) {
+			t.Errorf("GET %s: invalid JSON %s", tt.path, rec.Body)
+		}
+	}
+
+	var live []api.Match
+	if err := json.Unmarshal(get(t, handler, "/live", nil).Body.Bytes(), &live); err != nil || len(live) == 0 {
+		t.Errorf("/live = %d matches (%v), want the mock live matches", len(live), err)
+	}
+}
+
+func TestPartialResultsListFailedLeagues(t *testing.T) {
+	handler := New(partialProvider{mock.NewClient()}, Options{}).Handler()
+
+	rec := get(t, handler, "/live", nil)
+	if rec.Code != http.StatusOK || rec.Header().Get(FailedLeaguesHeader) != "87" {
+		t.Errorf("GET /live = %d with %s %q, want 200 listing league 87", rec.Code, FailedLeaguesHeader, rec.Header().Get(FailedLeaguesHeader))
+	}
+}
+
+func TestCORS(t *testing.T) {
+	handler := New(mock.NewClient(), Options{CORSOrigins: []string{"http://dashboard.local"}}).Handler()
+
+	allowed := http.Header{"Origin": {"http://dashboard.local"}}
+	if got := get(t, handler, "/healthz", allowed).Header().Get("Access-Control-Allow-Origin"); got != "http://dashboard.local" {
+		t.Errorf("allowed origin: Access-Control-Allow-Origin = %q", got)
+	}
+	other := http.Header{"Origin": {"http://elsewhere.local"}}
+	if got := get(t, handler, "/healthz", other).Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("other origin: Access-Control-Allow-Origin = %q, want none", got)
+	}
+
+	req := httptest.NewRequest(http.MethodOptions, "/live", nil)
+	req.Header.Set("Origin", "http://dashboard.local")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Access-Control-Allow-Methods") == "" {
+		t.Errorf("preflight = %d %v, want 204 with the allowed methods", rec.Code, rec.Header())
+	}
+}
+
+func TestEventsStream(t *testing.T) {
+	s := New(mock.NewClient(), Options{})
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/events?match=7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+
+	// Wait for the subscription before publishing
+	for deadline := time.Now().Add(2 * time.Second); s.hub.count() == 0; {
+		if time.Now().After(deadline) {
+			t.Fatal("stream never subscribed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.hub.publish(watch.Event{Type: watch.EventCard, MatchID: 8, Player: "Filtered out"})
+	s.hub.publish(watch.Event{Type: watch.EventGoal, MatchID: 7, Player: "Saka"})
+
+	got := readEvents(t, resp, 3)
+	if got[0] != "id: 2" || got[1] != "event: goal" || !strings.Contains(got[2], `"player":"Saka"`) {
+		t.Errorf("event = %q, want the goal of match 7 only", got)
+	}
+}
+
+func TestEventsStreamResumes(t *testing.T) {
+	s := New(mock.NewClient(), Options{})
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+	for _, player := range []string{"Saka", "Palmer", "Rice"} {
+		s.hub.publish(watch.Event{Type: watch.EventGoal, MatchID: 7, Player: player})
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/events", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	got := readEvents(t, resp, 6)
+	if got[0] != "id: 2" || !strings.Contains(got[2], "Palmer") || got[3] != "id: 3" || !strings.Contains(got[5], "Rice") {
+		t.Errorf("replayed %q, want the events after 1", got)
+	}
+}
+
+// readEvents reads n field lines (id, event, data) from an /events stream,
+// skipping comments and blank lines.
+func readEvents(t *testing.T, resp *http.Response, n int) []string {
+	t.Helper()
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	var got []string
+	for len(got) < n {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatalf("stream closed after %q", got)
+			}
+			if line != "" && !strings.HasPrefix(line, ":") {
+				got = append(got, line)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out after %q", got)
+		}
+	}
+	return got
+}
+
+func TestRunCallsOnPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	polled := make(chan watch.Update, 1)
+	s := New(mock.NewClient(), Options{OnPoll: func(update watch.Update) {
+		polled <- update
+		cancel()
+	}})
+
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	select {
+	case update := <-polled:
+		if update.Err != nil || len(update.Matches) == 0 {
+			t.Errorf("OnPoll got %d matches (%v), want the mock live matches", len(update.Matches), update.Err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("OnPoll never called")
+	}
+	<-done
+}
@@ -0,0 +1,213 @@
+// Package statusbar builds the one-line live scores printed by
+// "golazo status" for tmux, i3blocks, polybar or waybar.
+package statusbar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Presets are the --preset templates.
+var Presets = map[string]string{
+	// i3blocks, polybar and anything else showing a line of text
+	"plain": `{{.Text}}`,
+	// tmux status-left/status-right, with favourites in bold
+	"tmux": `{{range $i, $m := .Shown}}{{if $i}} · {{end}}{{if $m.Favourite}}#[bold]{{end}}{{$m.Home}} {{$m.Score}} {{$m.Away}} {{$m.Clock}}{{if $m.Favourite}}#[nobold]{{end}}{{end}}{{if .More}} +{{.More}}{{end}}`,
+	// waybar custom module with "return-type": "json"
+	"waybar": `{"text": {{json .Text}}, "tooltip": {{json .Tooltip}}, "class": {{json .Class}}}`,
+}
+
+// Options are the status command's flags.
+type Options struct {
+	MaxAge     time.Duration // Reuse matches fetched less than this long ago
+	Limit      int           // Matches shown on the line, 0 for all
+	Favourites bool          // Show the favourite teams' matches only
+	// Shared reuses and saves the matches through the state file. Recorded
+	// and replayed sessions turn it off, as they must see every request.
+	Shared bool
+}
+
+// Line is what --format templates are executed with.
+type Line struct {
+	Matches []Match   // Live matches, favourites first
+	Shown   []Match   // The first Limit matches
+	More    int       // Matches left out of Shown
+	Text    string    // Shown as one line: "ARS 1-0 CHE 67' · LIV 2-2 EVE HT +1"
+	Tooltip string    // Every match with league and full team names, one per line
+	Class   string    // "favourite" when a favourite team is playing, "live", or "none"
+	Updated time.Time // When the matches were fetched
+	Stale   bool      // The last fetch failed: the matches are older
+}
+
+// Match is a live match in a status line.
+type Match struct {
+	ID        int
+	League    string
+	Home      string // Short names
+	Away      string
+	HomeName  string
+	AwayName  string
+	HomeScore int
+	AwayScore int
+	Score     string // "1-0"
+	Clock     string // "67'", "HT"
+	Favourite bool   // A favourite team is playing
+}
+
+// Template parses a --format template, with the json function.
+func Template(text string) (*template.Template, error) {
+	return template.New("status").Funcs(template.FuncMap{"json": toJSON}).Parse(text)
+}
+
+// Key identifies what a status line shows (provider and leagues), so the
+// state file is only reused by invocations showing the same.
+func Key(settings *data.Settings, favourites bool) string {
+	key := fmt.Sprintf("%s:%v", settings.Provider, data.GetActiveLeagueIDs())
+	if favourites {
+		key += fmt.Sprintf(":favourites%v", settings.FavouriteLeagueIDs())
+	}
+	return key
+}
+
+// Matches returns the live matches from the state file while it is fresh for
+// key, otherwise calls fetch and saves them for the next invocations. A
+// failed fetch falls back to the previous matches, if any. fetch reports
+// incomplete results without an error.
+func Matches(key string, opts Options, fetch func() ([]api.Match, error)) (*data.StatusState, error) {
+	var state *data.StatusState
+	if opts.Shared {
+		state = data.LoadStatusState()
+	}
+	if state != nil && state.Key != key {
+		state = nil
+	}
+	if state == nil || time.Since(state.CheckedAt) >= opts.MaxAge {
+		state = refresh(key, state, fetch)
+		if opts.Shared {
+			_ = data.SaveStatusState(state) // Best-effort save: the next run fetches again
+		}
+	}
+
+	if state.FetchedAt.IsZero() {
+		return nil, errors.New(state.Error)
+	}
+	return state, nil
+}
+
+// refresh fetches the live matches, keeping the previous ones (if any) when
+// that fails.
+func refresh(key string, previous *data.StatusState, fetch func() ([]api.Match, error)) *data.StatusState {
+	matches, err := fetch()
+
+	now := time.Now()
+	state := &data.StatusState{Key: key, CheckedAt: now, FetchedAt: now}
+	for _, match := range matches {
+		if match.Status == api.MatchStatusLive {
+			state.Matches = append(state.Matches, match)
+		}
+	}
+	if err != nil {
+		state.Error = err.Error()
+		state.FetchedAt, state.Matches = time.Time{}, nil
+		if previous != nil {
+			state.FetchedAt, state.Matches = previous.FetchedAt, previous.Matches
+		}
+	}
+	return state
+}
+
+// NewLine builds the template data from the fetched matches.
+func NewLine(state *data.StatusState, settings *data.Settings, opts Options) Line {
+	line := Line{Updated: state.FetchedAt, Stale: state.Error != "", Class: "none"}
+
+	for _, match := range state.Matches {
+		favourite := settings.IsFavourite(match.HomeTeam.ID, match.AwayTeam.ID)
+		if opts.Favourites && !favourite {
+			continue
+		}
+		m := Match{
+			ID:        match.ID,
+			League:    match.League.Name,
+			Home:      shortName(match.HomeTeam),
+			Away:      shortName(match.AwayTeam),
+			HomeName:  match.HomeTeam.Name,
+			AwayName:  match.AwayTeam.Name,
+			Score:     "-",
+			Clock:     clock(match),
+			Favourite: favourite,
+		}
+		if match.HomeScore != nil && match.AwayScore != nil {
+			m.HomeScore, m.AwayScore = *match.HomeScore, *match.AwayScore
+			m.Score = fmt.Sprintf("%d-%d", m.HomeScore, m.AwayScore)
+		}
+		line.Matches = append(line.Matches, m)
+	}
+	sort.SliceStable(line.Matches, func(i, j int) bool {
+		return line.Matches[i].Favourite && !line.Matches[j].Favourite
+	})
+
+	line.Shown = line.Matches
+	if opts.Limit > 0 && len(line.Shown) > opts.Limit {
+		line.Shown = line.Matches[:opts.Limit]
+		line.More = len(line.Matches) - opts.Limit
+	}
+
+	parts := make([]string, len(line.Shown))
+	for i, m := range line.Shown {
+		parts[i] = fmt.Sprintf("%s %s %s %s", m.Home, m.Score, m.Away, m.Clock)
+	}
+	line.Text = strings.Join(parts, " · ")
+	if line.More > 0 {
+		line.Text += fmt.Sprintf(" +%d", line.More)
+	}
+
+	tooltip := make([]string, len(line.Matches))
+	for i, m := range line.Matches {
+		tooltip[i] = fmt.Sprintf("%s: %s %s %s (%s)", m.League, m.HomeName, m.Score, m.AwayName, m.Clock)
+	}
+	if len(tooltip) == 0 {
+		tooltip = append(tooltip, "No live matches")
+	}
+	if line.Stale {
+		tooltip = append(tooltip, "Not updated since "+data.FormatClock(line.Updated))
+	}
+	line.Tooltip = strings.Join(tooltip, "\n")
+
+	switch {
+	case len(line.Matches) > 0 && line.Matches[0].Favourite:
+		line.Class = "favourite"
+	case len(line.Matches) > 0:
+		line.Class = "live"
+	}
+	return line
+}
+
+// clock returns a live match's clock, "live" when the provider has none.
+func clock(match api.Match) string {
+	if match.LiveTime != nil && *match.LiveTime != "" {
+		return *match.LiveTime
+	}
+	return "live"
+}
+
+// shortName returns a team's short name, or its name when it has none.
+func shortName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
+
+// toJSON is the json template function.
+func toJSON(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	return string(raw), err
+}
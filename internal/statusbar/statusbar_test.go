@@ -0,0 +1,182 @@
+package statusbar
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// setup isolates the state file in a temp cache dir.
+func setup(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+}
+
+func live(id int, home, away string, homeScore, awayScore int, clock string) api.Match {
+	return api.Match{
+		ID:        id,
+		League:    api.League{Name: "Premier League"},
+		HomeTeam:  api.Team{ID: id * 10, Name: home + " FC", ShortName: home},
+		AwayTeam:  api.Team{ID: id*10 + 1, Name: away + " FC", ShortName: away},
+		HomeScore: &homeScore,
+		AwayScore: &awayScore,
+		LiveTime:  &clock,
+		Status:    api.MatchStatusLive,
+	}
+}
+
+// fetcher counts its calls and returns the matches or error set on it.
+type fetcher struct {
+	calls   int
+	matches []api.Match
+	err     error
+}
+
+func (f *fetcher) fetch() ([]api.Match, error) {
+	f.calls++
+	return f.matches, f.err
+}
+
+func TestMatchesReusesState(t *testing.T) {
+	setup(t)
+	f := &fetcher{matches: []api.Match{live(1, "ARS", "CHE", 1, 0, "67'"), {ID: 2, Status: api.MatchStatusFinished}}}
+	opts := Options{MaxAge: time.Hour, Shared: true}
+
+	state, err := Matches("fotmob:[47]", opts, f.fetch)
+	if err != nil {
+		t.Fatalf("matches: %v", err)
+	}
+	if len(state.Matches) != 1 || state.Matches[0].ID != 1 {
+		t.Fatalf("matches = %+v, want the live match only", state.Matches)
+	}
+
+	// A later invocation reads the state file
+	if _, err := Matches("fotmob:[47]", opts, f.fetch); err != nil || f.calls != 1 {
+		t.Errorf("fresh state: %d fetches, err %v; want 1 and none", f.calls, err)
+	}
+
+	// Showing something else fetches again
+	if _, err := Matches("fotmob:[47 42]", opts, f.fetch); err != nil || f.calls != 2 {
+		t.Errorf("other key: %d fetches, err %v; want 2 and none", f.calls, err)
+	}
+
+	// So does an expired state
+	opts.MaxAge = 0
+	if _, err := Matches("fotmob:[47 42]", opts, f.fetch); err != nil || f.calls != 3 {
+		t.Errorf("expired state: %d fetches, err %v; want 3 and none", f.calls, err)
+	}
+
+	// Unshared invocations fetch every time
+	opts = Options{MaxAge: time.Hour}
+	if _, err := Matches("fotmob:[47 42]", opts, f.fetch); err != nil || f.calls != 4 {
+		t.Errorf("unshared: %d fetches, err %v; want 4 and none", f.calls, err)
+	}
+}
+
+func TestMatchesFallsBackWhenFetchFails(t *testing.T) {
+	setup(t)
+	f := &fetcher{matches: []api.Match{live(1, "ARS", "CHE", 1, 0, "67'")}}
+	opts := Options{MaxAge: 0, Shared: true}
+
+	first, err := Matches("fotmob:[47]", opts, f.fetch)
+	if err != nil {
+		t.Fatalf("matches: %v", err)
+	}
+
+	// The previous matches are kept, marked stale
+	f.matches, f.err = nil, errors.New("timeout")
+	state, err := Matches("fotmob:[47]", opts, f.fetch)
+	if err != nil {
+		t.Fatalf("failed fetch: %v", err)
+	}
+	if len(state.Matches) != 1 || !state.FetchedAt.Equal(first.FetchedAt) || state.Error != "timeout" {
+		t.Errorf("state = %+v, want the previous match, fetch time and the error", state)
+	}
+	if line := NewLine(state, &data.Settings{}, opts); !line.Stale || !strings.Contains(line.Tooltip, "Not updated since") {
+		t.Errorf("line = %+v, want stale", line)
+	}
+
+	// Without previous matches, the failure is an error
+	if _, err := Matches("fotmob:[42]", opts, f.fetch); err == nil || err.Error() != "timeout" {
+		t.Errorf("err = %v, want timeout", err)
+	}
+}
+
+func TestNewLine(t *testing.T) {
+	state := &data.StatusState{Matches: []api.Match{
+		live(1, "ARS", "CHE", 1, 0, "67'"),
+		live(2, "LIV", "EVE", 2, 2, "HT"),
+		live(3, "MUN", "NEW", 0, 1, "12'"),
+	}}
+	settings := &data.Settings{FavouriteTeams: []data.FavouriteTeam{{ID: 20}}}
+
+	line := NewLine(state, settings, Options{Limit: 2})
+	if line.Text != "LIV 2-2 EVE HT · ARS 1-0 CHE 67' +1" {
+		t.Errorf("text = %q", line.Text)
+	}
+	if line.Class != "favourite" || line.More != 1 || len(line.Shown) != 2 {
+		t.Errorf("class = %q, more = %d, shown = %d", line.Class, line.More, len(line.Shown))
+	}
+
+	favourites := NewLine(state, settings, Options{Favourites: true})
+	if len(favourites.Matches) != 1 || favourites.Matches[0].ID != 2 {
+		t.Errorf("favourites = %+v, want LIV-EVE only", favourites.Matches)
+	}
+
+	empty := NewLine(&data.StatusState{}, settings, Options{})
+	if empty.Class != "none" || empty.Tooltip != "No live matches" {
+		t.Errorf("empty line = %+v", empty)
+	}
+}
+
+func TestPresets(t *testing.T) {
+	quote := `"Quote" FC`
+	match := live(1, "ARS", "CHE", 1, 0, "67'")
+	match.HomeTeam.Name = quote
+	state := &data.StatusState{Matches: []api.Match{match, live(2, "LIV", "EVE", 2, 2, "HT")}}
+	settings := &data.Settings{FavouriteTeams: []data.FavouriteTeam{{ID: 10}}}
+	line := NewLine(state, settings, Options{Limit: 1})
+
+	execute := func(preset string) string {
+		t.Helper()
+		tmpl, err := Template(Presets[preset])
+		if err != nil {
+			t.Fatalf("parse %s: %v", preset, err)
+		}
+		var out strings.Builder
+		if err := tmpl.Execute(&out, line); err != nil {
+			t.Fatalf("execute %s: %v", preset, err)
+		}
+		return out.String()
+	}
+
+	var waybar struct {
+		Text    string `json:"text"`
+		Tooltip string `json:"tooltip"`
+		Class   string `json:"class"`
+	}
+	if err := json.Unmarshal([]byte(execute("waybar")), &waybar); err != nil {
+		t.Fatalf("waybar output isn't JSON: %v", err)
+	}
+	if waybar.Text != line.Text || waybar.Tooltip != line.Tooltip || waybar.Class != "favourite" {
+		t.Errorf("waybar = %+v", waybar)
+	}
+	if !strings.Contains(waybar.Tooltip, quote) || !strings.Contains(waybar.Tooltip, "\n") {
+		t.Errorf("tooltip = %q, want both matches with full names", waybar.Tooltip)
+	}
+
+	if got, want := execute("tmux"), "#[bold]ARS 1-0 CHE 67'#[nobold] +1"; got != want {
+		t.Errorf("tmux = %q, want %q", got, want)
+	}
+	if got := execute("plain"); got != "ARS 1-0 CHE 67' +1" {
+		t.Errorf("plain = %q", got)
+	}
+}
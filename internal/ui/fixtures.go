@@ -0,0 +1,355 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FixturesState holds the state for the league fixtures browser.
+// The browser pages through a season round by round; any season FotMob
+// lists for the league can be loaded.
+type FixturesState struct {
+	Leagues    []data.LeagueInfo // Leagues available in the picker (from settings)
+	Index      int               // Currently selected league in Leagues
+	Season     *api.LeagueSeason // Loaded season (nil while loading)
+	Rounds     []api.LeagueRound // Season matches grouped by round
+	RoundIndex int               // Currently shown round in Rounds
+	Requested  string            // Season to load ("" = current season)
+	Offset     int               // Scroll offset in rendered lines
+	Loading    bool
+	Err        error
+}
+
+// NewFixturesState creates a fixtures browser state for the given leagues.
+// If focusLeagueID is non-zero, the picker starts on that league (added if missing).
+func NewFixturesState(leagues []data.LeagueInfo, focusLeagueID int) *FixturesState {
+	state := &FixturesState{}
+	state.Leagues, state.Index = focusLeague(leagues, focusLeagueID)
+	return state
+}
+
+// CurrentLeague returns the league currently selected in the picker.
+func (s *FixturesState) CurrentLeague() data.LeagueInfo {
+	if len(s.Leagues) == 0 {
+		return data.LeagueInfo{}
+	}
+	return s.Leagues[s.Index]
+}
+
+// SetSeason stores a loaded season and jumps to its current round:
+// the first round with a match still to be played, or the last round
+// when the season is over.
+func (s *FixturesState) SetSeason(season *api.LeagueSeason) {
+	s.Season = season
+	s.Rounds = nil
+	s.RoundIndex = 0
+	s.Offset = 0
+	if season == nil {
+		return
+	}
+
+	s.Rounds = season.Rounds()
+	s.RoundIndex = len(s.Rounds) - 1
+	for i, round := range s.Rounds {
+		if roundHasPendingMatch(round) {
+			s.RoundIndex = i
+			break
+		}
+	}
+	if s.RoundIndex < 0 {
+		s.RoundIndex = 0
+	}
+}
+
+// roundHasPendingMatch reports whether any match in the round hasn't finished.
+func roundHasPendingMatch(round api.LeagueRound) bool {
+	for _, match := range round.Matches {
+		if match.Status == api.MatchStatusNotStarted || match.Status == api.MatchStatusLive {
+			return true
+		}
+	}
+	return false
+}
+
+// NextRound moves to the next round (stops at the last one).
+func (s *FixturesState) NextRound() {
+	if s.RoundIndex < len(s.Rounds)-1 {
+		s.RoundIndex++
+		s.Offset = 0
+	}
+}
+
+// PrevRound moves to the previous round (stops at the first one).
+func (s *FixturesState) PrevRound() {
+	if s.RoundIndex > 0 {
+		s.RoundIndex--
+		s.Offset = 0
+	}
+}
+
+// NextLeague moves the picker to the next league (wraps around) and resets to its current season.
+func (s *FixturesState) NextLeague() {
+	if len(s.Leagues) == 0 {
+		return
+	}
+	s.Index = (s.Index + 1) % len(s.Leagues)
+	s.Requested = ""
+	s.reset()
+}
+
+// PrevLeague moves the picker to the previous league (wraps around) and resets to its current season.
+func (s *FixturesState) PrevLeague() {
+	if len(s.Leagues) == 0 {
+		return
+	}
+	s.Index = (s.Index - 1 + len(s.Leagues)) % len(s.Leagues)
+	s.Requested = ""
+	s.reset()
+}
+
+// OlderSeason selects the season before the loaded one.
+// Returns false if there is no older season (or none is loaded yet).
+func (s *FixturesState) OlderSeason() bool {
+	return s.stepSeason(1)
+}
+
+// NewerSeason selects the season after the loaded one.
+// Returns false if the loaded season is already the newest.
+func (s *FixturesState) NewerSeason() bool {
+	return s.stepSeason(-1)
+}
+
+// stepSeason moves through Season.Seasons (newest first) by delta.
+func (s *FixturesState) stepSeason(delta int) bool {
+	if s.Season == nil || s.Loading {
+		return false
+	}
+
+	current := -1
+	for i, season := range s.Season.Seasons {
+		if season == s.Season.Season {
+			current = i
+			break
+		}
+	}
+
+	next := current + delta
+	if current < 0 || next < 0 || next >= len(s.Season.Seasons) {
+		return false
+	}
+
+	s.Requested = s.Season.Seasons[next]
+	s.reset()
+	return true
+}
+
+// ScrollDown scrolls the round down by n lines.
+func (s *FixturesState) ScrollDown(n int) {
+	s.Offset += n
+}
+
+// ScrollUp scrolls the round up by n lines.
+func (s *FixturesState) ScrollUp(n int) {
+	s.Offset -= n
+	if s.Offset < 0 {
+		s.Offset = 0
+	}
+}
+
+// reset clears season data before loading a new league or season.
+func (s *FixturesState) reset() {
+	s.Season = nil
+	s.Rounds = nil
+	s.RoundIndex = 0
+	s.Err = nil
+	s.Offset = 0
+}
+
+// Fixed width for the fixtures browser
+const fixturesBoxWidth = 64
+
+// RenderFixturesView renders the fixtures browser with league picker, season
+// and round selectors, and the matches of the selected round.
+// Uses minimal styling consistent with the standings view (red/cyan neon theme).
+func RenderFixturesView(width, height int, state *FixturesState, randomSpinner *RandomCharSpinner) string {
+	if state == nil {
+		return ""
+	}
+
+	const (
+		titleHeight  = 3 // Title + league picker + round picker
+		helpHeight   = 1 // Help text
+		extraPadding = 4 // Additional vertical spacing
+	)
+
+	title := neonPanelTitleStyle.Width(fixturesBoxWidth).Render(constants.PanelFixtures)
+	leaguePicker := renderPicker(state.CurrentLeague().Name, "No leagues selected", state.Index, len(state.Leagues), fixturesBoxWidth)
+	roundPicker := renderRoundPicker(state, fixturesBoxWidth)
+
+	bodyHeight := height - titleHeight - helpHeight - extraPadding - 2
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+
+	var body string
+	emptyStyle := neonEmptyStyle.Width(fixturesBoxWidth)
+	switch {
+	case state.Loading:
+		spinnerView := "Loading..."
+		if randomSpinner != nil {
+			spinnerView = randomSpinner.View()
+		}
+		body = emptyStyle.Render(spinnerView)
+	case state.Err != nil:
+		body = emptyStyle.Render("Could not load fixtures\n\n" + state.Err.Error())
+	case len(state.Rounds) == 0:
+		body = emptyStyle.Render(constants.EmptyNoFixtures)
+	default:
+		lines := renderRoundMatches(state.Rounds[state.RoundIndex])
+		// Clamp scroll offset so the last line stays in view
+		maxOffset := len(lines) - bodyHeight
+		if maxOffset < 0 {
+			maxOffset = 0
+		}
+		if state.Offset > maxOffset {
+			state.Offset = maxOffset
+		}
+		end := state.Offset + bodyHeight
+		if end > len(lines) {
+			end = len(lines)
+		}
+		body = strings.Join(lines[state.Offset:end], "\n")
+	}
+
+	help := neonDimStyle.Width(fixturesBoxWidth).Align(lipgloss.Center).Render(constants.HelpFixturesView)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		leaguePicker,
+		roundPicker,
+		"",
+		body,
+		"",
+		help,
+	)
+
+	return lipgloss.Place(
+		width,
+		height,
+		lipgloss.Center,
+		lipgloss.Center,
+		content,
+	)
+}
+
+// renderRoundPicker renders "2024/2025 · ‹ Matchday 12 (12/38) ›" centered.
+func renderRoundPicker(state *FixturesState, width int) string {
+	season := state.Requested
+	if state.Season != nil && state.Season.Season != "" {
+		season = state.Season.Season
+	}
+
+	if len(state.Rounds) == 0 {
+		if season == "" {
+			return ""
+		}
+		return neonDimStyle.Width(width).Align(lipgloss.Center).Render(season)
+	}
+
+	round := renderPicker(roundLabel(state.Rounds[state.RoundIndex].Name), "", state.RoundIndex, len(state.Rounds), 0)
+	if season != "" {
+		round = neonDimStyle.Render(season+" · ") + round
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(round)
+}
+
+// roundLabel turns FotMob's numeric round names into "Matchday N".
+func roundLabel(name string) string {
+	if _, err := strconv.Atoi(name); err == nil {
+		return "Matchday " + name
+	}
+	return name
+}
+
+// renderRoundMatches renders one line per match, with a dim date header
+// whenever the kickoff day changes.
+func renderRoundMatches(round api.LeagueRound) []string {
+	var lines []string
+	currentDay := ""
+	for _, match := range round.Matches {
+		day := "Date TBC"
+		if match.MatchTime != nil {
+			day = data.FormatDate(*match.MatchTime, "Monday 2 January")
+		}
+		if day != currentDay {
+			if currentDay != "" {
+				lines = append(lines, "")
+			}
+			lines = append(lines, neonHeaderStyle.Render(day))
+			currentDay = day
+		}
+		lines = append(lines, renderFixtureLine(match))
+	}
+	return lines
+}
+
+// renderFixtureLine renders "  15:00  Arsenal        2 - 1  Chelsea".
+// Upcoming matches show "vs" instead of a score; live matches show the minute.
+func renderFixtureLine(match api.Match) string {
+	kickoff := kickoffTime(match.MatchTime)
+
+	home := match.HomeTeam.ShortName
+	if home == "" {
+		home = match.HomeTeam.Name
+	}
+	away := match.AwayTeam.ShortName
+	if away == "" {
+		away = match.AwayTeam.Name
+	}
+	home = truncateString(home, 22)
+	away = truncateString(away, 22)
+
+	scoreStyle := neonDimStyle
+	score := "vs"
+	switch match.Status {
+	case api.MatchStatusFinished, api.MatchStatusLive:
+		if match.HomeScore != nil && match.AwayScore != nil {
+			score = fmt.Sprintf("%d - %d", *match.HomeScore, *match.AwayScore)
+			scoreStyle = neonScoreStyle
+		}
+	case api.MatchStatusCancelled:
+		score = "PP"
+	}
+
+	status := ""
+	if match.Status == api.MatchStatusLive {
+		kickoff = "LIVE"
+		if match.LiveTime != nil {
+			status = " " + neonLiveStyle.Render(*match.LiveTime)
+		}
+	}
+
+	return fmt.Sprintf("  %s  %s %s %s%s",
+		neonDimStyle.Render(fmt.Sprintf("%-*s", kickoffWidth(), kickoff)),
+		neonValueStyle.Render(fmt.Sprintf("%22s", home)),
+		scoreStyle.Render(fmt.Sprintf("%-5s", centerText(score, 5))),
+		neonValueStyle.Render(away),
+		status,
+	)
+}
+
+// centerText pads s with spaces to center it within width.
+func centerText(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	left := (width - len(s)) / 2
+	return strings.Repeat(" ", left) + s
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"io"
+
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -12,12 +14,30 @@ var (
 	delegateNeonWhite = lipgloss.Color("255")
 	delegateNeonGray  = lipgloss.Color("244")
 	delegateNeonDim   = lipgloss.Color("238")
+	delegateNeonGold  = lipgloss.Color("220")
 )
 
+// MatchListDelegate renders match items like list.DefaultDelegate, with
+// matches involving a favourite team in their own gold styles.
+type MatchListDelegate struct {
+	list.DefaultDelegate
+	FavouriteStyles list.DefaultItemStyles
+}
+
+// Render renders a match item, using FavouriteStyles for favourite matches.
+func (d MatchListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	delegate := d.DefaultDelegate
+	if match, ok := item.(MatchListItem); ok && match.Display.Favourite() {
+		delegate.Styles = d.FavouriteStyles
+	}
+	delegate.Render(w, m, index, item)
+}
+
 // NewMatchListDelegate creates a custom list delegate for match items.
 // Height is set to 3 to accommodate title + 2-line description (with KO time).
 // Uses Neon Gradient styling: red title, cyan description on selection.
-func NewMatchListDelegate() list.DefaultDelegate {
+// Favourite matches have a gold title and left border.
+func NewMatchListDelegate() MatchListDelegate {
 	d := list.NewDefaultDelegate()
 
 	// Set height to 3 lines: title (1) + description with KO time (2)
@@ -65,7 +85,21 @@ func NewMatchListDelegate() list.DefaultDelegate {
 		Bold(true).
 		Underline(true)
 
-	return d
+	// Favourite matches: gold title, and a gold left border when not selected
+	// so they stand out from the matches below them
+	favourite := d.Styles
+	favourite.SelectedTitle = favourite.SelectedTitle.Foreground(delegateNeonGold)
+	favourite.NormalTitle = favourite.NormalTitle.
+		Foreground(delegateNeonGold).
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(delegateNeonGold).
+		Padding(0, 1, 0, 0)
+	favourite.NormalDesc = favourite.NormalDesc.
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(delegateNeonGold).
+		Padding(0, 1, 0, 0)
+
+	return MatchListDelegate{DefaultDelegate: d, FavouriteStyles: favourite}
 }
 
 // NewLeagueListDelegate creates a custom list delegate for league selection.
@@ -115,3 +149,13 @@ func NewLeagueListDelegate() list.DefaultDelegate {
 
 	return d
 }
+
+// NewNotificationListDelegate creates a list delegate for the notification
+// rules in settings: the league delegate's styling on a single line.
+func NewNotificationListDelegate() list.DefaultDelegate {
+	d := NewLeagueListDelegate()
+	d.ShowDescription = false
+	d.SetHeight(1)
+	d.SetSpacing(0)
+	return d
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -102,12 +103,7 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 // renderUpcomingMatchLine renders a single upcoming match as a simple text line.
 func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 	// Format: "  HH:MM  Team A vs Team B"
-	var timeStr string
-	if match.MatchTime != nil {
-		timeStr = match.MatchTime.Local().Format("15:04")
-	} else {
-		timeStr = "--:--"
-	}
+	timeStr := kickoffTime(match.MatchTime)
 
 	homeTeam := match.HomeTeam.ShortName
 	if homeTeam == "" {
@@ -119,7 +115,7 @@ func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 	}
 
 	// Truncate team names if too long
-	maxTeamLen := (maxWidth - 15) / 2 // 15 = time(5) + " vs "(4) + padding(6)
+	maxTeamLen := (maxWidth - kickoffWidth() - 10) / 2 // time + " vs "(4) + padding(6)
 	if len(homeTeam) > maxTeamLen {
 		homeTeam = homeTeam[:maxTeamLen-1] + "…"
 	}
@@ -141,15 +137,23 @@ func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 // Uses Neon design with Golazo red/cyan theme.
 // List titles are only shown when there are items. Empty lists show gray messages instead.
 // Upcoming matches are now shown in the Live view instead.
-func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange int) string {
+// loadWarning, when set, notes that some leagues failed to load (shown under the date selector).
+func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange int, loadWarning string) string {
 	// Render date range selector with neon styling
 	dateSelector := renderDateRangeSelector(width-6, dateRange)
+	if loadWarning != "" {
+		dateSelector = lipgloss.JoinVertical(lipgloss.Left, dateSelector,
+			neonDimStyle.Width(width-6).Align(lipgloss.Center).Render(loadWarning))
+	}
 
 	emptyStyle := neonEmptyStyle.Width(width - 6)
 
 	var finishedListView string
 	finishedItems := finishedList.Items()
-	if len(finishedItems) == 0 {
+	if len(finishedItems) == 0 && loadWarning != "" {
+		// Nothing loaded because requests failed - don't claim there were no matches
+		finishedListView = emptyStyle.Render(constants.EmptyLoadFailed + "\n\nTry again later")
+	} else if len(finishedItems) == 0 {
 		// No items - show empty message, no list title
 		finishedListView = emptyStyle.Render(constants.EmptyNoFinishedMatches + "\n\nTry selecting a different date range (h/l keys)")
 	} else {
@@ -309,7 +313,7 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 // Rebuilt to match live view structure exactly: spinner at top, left panel (matches), right panel (details).
 // daysLoaded and totalDays show loading progress during progressive loading.
 // Note: Upcoming matches are now shown in the Live view instead.
-func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int) string {
+func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, loadWarning string) string {
 	// Handle edge case: if width/height not set, use defaults
 	if width <= 0 {
 		width = 80
@@ -367,7 +371,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	panelHeight := availableHeight - 2
 
 	// Render left panel (finished matches list) - match live view structure
-	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange)
+	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, loadWarning)
 
 	// Render right panel (match details) - use dedicated stats panel renderer
 	rightPanel := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details)
@@ -461,7 +465,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails)
 		lines = append(lines, neonLabelStyle.Render("Venue:       ")+neonValueStyle.Render(truncateString(details.Venue, contentWidth-14)))
 	}
 	if details.MatchTime != nil {
-		lines = append(lines, neonLabelStyle.Render("Date:        ")+neonValueStyle.Render(details.MatchTime.Format("02 Jan 2006, 15:04")+" UTC"))
+		lines = append(lines, neonLabelStyle.Render("Date:        ")+neonValueStyle.Render(data.FormatDate(*details.MatchTime, "02 Jan 2006")+", "+data.FormatClock(*details.MatchTime)+" "+data.FormatDate(*details.MatchTime, "MST")))
 	}
 	if details.Referee != "" {
 		lines = append(lines, neonLabelStyle.Render("Referee:     ")+neonValueStyle.Render(details.Referee))
@@ -3,13 +3,28 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
+// favouriteMark precedes favourite teams in match titles.
+const favouriteMark = "★ "
+
 // MatchDisplay wraps a match with display information for rendering.
 type MatchDisplay struct {
 	api.Match
+
+	// Favourite teams are starred, and their matches styled apart by the match
+	// list delegate
+	HomeFavourite bool
+	AwayFavourite bool
+}
+
+// Favourite reports whether either team is a favourite.
+func (m MatchDisplay) Favourite() bool {
+	return m.HomeFavourite || m.AwayFavourite
 }
 
 // Title returns a formatted title for the match.
@@ -22,6 +37,12 @@ func (m MatchDisplay) Title() string {
 	if away == "" {
 		away = m.AwayTeam.Name
 	}
+	if m.HomeFavourite {
+		home = favouriteMark + home
+	}
+	if m.AwayFavourite {
+		away = favouriteMark + away
+	}
 	return home + " vs " + away
 }
 
@@ -49,8 +70,23 @@ func (m MatchDisplay) Description() string {
 
 	// Add start time (kick-off time) on second line
 	if m.MatchTime != nil {
-		return line1 + "\nKO " + m.MatchTime.Local().Format("15:04")
+		return line1 + "\nKO " + kickoffTime(m.MatchTime)
 	}
 
 	return line1
 }
+
+// kickoffTime formats a kickoff in the configured timezone and clock format,
+// or "--:--" when the time is unknown.
+func kickoffTime(t *time.Time) string {
+	if t == nil {
+		return "--:--"
+	}
+	return data.FormatClock(*t)
+}
+
+// kickoffWidth returns the display width of a kickoff time ("15:04" or "12:00 AM"),
+// for aligning columns.
+func kickoffWidth() int {
+	return len(data.FormatClock(time.Time{}))
+}
@@ -736,6 +736,9 @@ func formatMatchEventForDisplay(event api.MatchEvent, homeTeamID int, contentWid
 		if event.Assist != nil && *event.Assist != "" {
 			assistText = fmt.Sprintf(" (%s)", *event.Assist)
 		}
+		if event.EventType != nil && *event.EventType == "penalty" {
+			assistText = " (pen)"
+		}
 		goalStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 		playerDetails := whiteStyle.Render(playerName + assistText)
 		eventContent = buildEventContent(playerDetails, "●", goalStyle.Render("GOAL"), isHome)
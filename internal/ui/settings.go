@@ -2,10 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -18,9 +22,32 @@ type SettingsState struct {
 	Selected   map[int]bool // Map of league ID -> selected
 	Leagues    []data.LeagueInfo
 	HasChanges bool // Whether there are unsaved changes
+
+	Timezone    string // IANA zone name, "" for the system zone
+	ClockFormat string // data.Clock24h or data.Clock12h
+
+	// Notifications section, shown instead of the leagues when Section is
+	// SettingsNotifications
+	Section       SettingsSection
+	Notifications list.Model
+	Rules         []data.NotificationRule
+	RulesChanged  bool // Rules are only written to settings.yaml once edited
+
+	// Favourites section: the favourite teams, or the teams found by a search
+	Favourites        list.Model
+	FavouriteTeams    []data.FavouriteTeam
+	FavouritesChanged bool // Written to settings.yaml once edited
+	Search            textinput.Model
+	Searching         bool   // Typing in the search box
+	SearchQuery       string // Query the results are for, "" to show the favourites
+	SearchResults     []api.TeamSearchResult
+	SearchErr         error
+	SearchLoading     bool
 }
 
 // NewSettingsState creates a new settings state with current saved preferences.
+// Leagues come from the cached FotMob catalog (built-in list when offline),
+// grouped by country.
 func NewSettingsState() *SettingsState {
 	settings, _ := data.LoadSettings()
 
@@ -34,7 +61,7 @@ func NewSettingsState() *SettingsState {
 		}
 	}
 
-	leagues := data.AllSupportedLeagues
+	leagues := withSelectedLeagues(data.LeagueCatalog(), selected)
 
 	// Create list items
 	items := make([]list.Item, len(leagues))
@@ -61,11 +88,111 @@ func NewSettingsState() *SettingsState {
 	l.FilterInput.PromptStyle = filterPromptStyle
 	l.FilterInput.Cursor.Style = filterCursorStyle
 
+	clockFormat := data.Clock24h
+	if settings.Uses12HourClock() {
+		clockFormat = data.Clock12h
+	}
+
+	rules := append([]data.NotificationRule(nil), settings.NotificationRules()...)
+	favourites := append([]data.FavouriteTeam(nil), settings.FavouriteTeams...)
+
 	return &SettingsState{
-		List:     l,
-		Selected: selected,
-		Leagues:  leagues,
+		List:           l,
+		Selected:       selected,
+		Leagues:        leagues,
+		Timezone:       settings.Timezone,
+		ClockFormat:    clockFormat,
+		Notifications:  newNotificationList(rules),
+		Rules:          rules,
+		Favourites:     newFavouriteList(favourites),
+		FavouriteTeams: favourites,
+		Search:         newTeamSearchInput(),
+	}
+}
+
+// SetLeagues replaces the league catalog (e.g., after a fresh fetch from FotMob).
+// Selections are preserved and the cursor stays on the same league when possible.
+func (s *SettingsState) SetLeagues(leagues []data.LeagueInfo) {
+	if len(leagues) == 0 {
+		return
+	}
+
+	currentID := 0
+	if item, ok := s.List.SelectedItem().(LeagueListItem); ok {
+		currentID = item.League.ID
+	}
+
+	sorted := make([]data.LeagueInfo, len(leagues))
+	copy(sorted, leagues)
+	data.SortLeaguesByCountry(sorted)
+	s.Leagues = withSelectedLeagues(sorted, s.Selected)
+	s.refreshListItems()
+
+	for i, league := range s.Leagues {
+		if league.ID == currentID {
+			s.List.Select(i)
+			break
+		}
+	}
+}
+
+// NextCountry moves the cursor to the first league of the next country.
+func (s *SettingsState) NextCountry() {
+	index := s.List.Index()
+	if index < 0 || index >= len(s.Leagues) {
+		return
+	}
+	country := s.Leagues[index].Country
+	for i := index + 1; i < len(s.Leagues); i++ {
+		if s.Leagues[i].Country != country {
+			s.List.Select(i)
+			return
+		}
+	}
+}
+
+// PrevCountry moves the cursor to the first league of the current country,
+// or of the previous country if already there.
+func (s *SettingsState) PrevCountry() {
+	index := s.List.Index()
+	if index <= 0 || index >= len(s.Leagues) {
+		return
+	}
+	// Step back into the previous country when sitting on a country's first league
+	if s.Leagues[index-1].Country != s.Leagues[index].Country {
+		index--
+	}
+	country := s.Leagues[index].Country
+	for index > 0 && s.Leagues[index-1].Country == country {
+		index--
+	}
+	s.List.Select(index)
+}
+
+// withSelectedLeagues appends selected leagues missing from the catalog
+// (e.g., picked from the full catalog but now offline) so saving doesn't drop them.
+func withSelectedLeagues(leagues []data.LeagueInfo, selected map[int]bool) []data.LeagueInfo {
+	present := make(map[int]bool, len(leagues))
+	for _, league := range leagues {
+		present[league.ID] = true
+	}
+
+	var missing []int
+	for id, isSelected := range selected {
+		if isSelected && !present[id] {
+			missing = append(missing, id)
+		}
+	}
+	sort.Ints(missing)
+
+	for _, id := range missing {
+		info, ok := data.LeagueInfoByID(id)
+		if !ok {
+			info = data.LeagueInfo{ID: id, Name: fmt.Sprintf("League %d", id), Country: "Other"}
+		}
+		leagues = append(leagues, info)
 	}
+	return leagues
 }
 
 // Toggle toggles the selection state of the currently highlighted league.
@@ -77,6 +204,45 @@ func (s *SettingsState) Toggle() {
 	}
 }
 
+// CycleTimezone switches to the next timezone in data.TimezoneChoices,
+// starting from (and wrapping back to) the system zone.
+// A zone set by hand in settings.yaml is left by the first press.
+func (s *SettingsState) CycleTimezone() {
+	next := ""
+	if s.Timezone == "" {
+		next = data.TimezoneChoices[0]
+	} else {
+		for i, zone := range data.TimezoneChoices {
+			if zone == s.Timezone && i+1 < len(data.TimezoneChoices) {
+				next = data.TimezoneChoices[i+1]
+				break
+			}
+		}
+	}
+	s.Timezone = next
+	s.HasChanges = true
+}
+
+// ToggleClock switches between the 24-hour and 12-hour clock.
+func (s *SettingsState) ToggleClock() {
+	if s.ClockFormat == data.Clock12h {
+		s.ClockFormat = data.Clock24h
+	} else {
+		s.ClockFormat = data.Clock12h
+	}
+	s.HasChanges = true
+}
+
+// timeInfo describes the current timezone and clock settings,
+// e.g. "Time: System (Europe/Madrid) · 24h".
+func (s *SettingsState) timeInfo() string {
+	zone := s.Timezone
+	if zone == "" {
+		zone = "System (" + time.Local.String() + ")"
+	}
+	return fmt.Sprintf("Time: %s · %s", zone, s.ClockFormat)
+}
+
 // refreshListItems updates the list items to reflect current selection state.
 func (s *SettingsState) refreshListItems() {
 	items := make([]list.Item, len(s.Leagues))
@@ -89,7 +255,8 @@ func (s *SettingsState) refreshListItems() {
 	s.List.SetItems(items)
 }
 
-// Save persists the current selection to settings.yaml.
+// Save persists the current selection, time preferences, notification
+// rules and favourite teams to settings.yaml and applies the time preferences immediately.
 func (s *SettingsState) Save() error {
 	var selectedIDs []int
 	for _, league := range s.Leagues {
@@ -98,13 +265,24 @@ func (s *SettingsState) Save() error {
 		}
 	}
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
+	// Keep settings this view doesn't edit
+	settings, _ := data.LoadSettings()
+	settings.SelectedLeagues = selectedIDs
+	settings.Timezone = s.Timezone
+	settings.ClockFormat = s.ClockFormat
+	if s.RulesChanged {
+		settings.Notifications.Rules = s.Rules
 	}
+	if s.FavouritesChanged {
+		settings.FavouriteTeams = s.FavouriteTeams
+	}
+	data.ApplyTimeSettings(settings)
 
 	err := data.SaveSettings(settings)
 	if err == nil {
 		s.HasChanges = false
+		s.RulesChanged = false
+		s.FavouritesChanged = false
 	}
 	return err
 }
@@ -133,7 +311,7 @@ func RenderSettingsView(width, height int, state *SettingsState) string {
 	// Calculate available space for the list
 	const (
 		titleHeight  = 3 // Title + margin
-		infoHeight   = 2 // Selection info
+		infoHeight   = 3 // Selection and time info
 		helpHeight   = 2 // Help text
 		extraPadding = 4 // Additional vertical spacing
 	)
@@ -146,6 +324,9 @@ func RenderSettingsView(width, height int, state *SettingsState) string {
 
 	// Update list dimensions
 	state.List.SetSize(listWidth, listHeight)
+	state.Notifications.SetSize(listWidth, listHeight)
+	state.Favourites.SetSize(listWidth, listHeight-2) // Room for the search box
+	state.Search.Width = listWidth - len(state.Search.Prompt) - 1
 
 	// Title - red like other panel titles
 	titleStyle := neonPanelTitleStyle.Width(settingsBoxWidth)
@@ -162,12 +343,34 @@ func RenderSettingsView(width, height int, state *SettingsState) string {
 	} else {
 		infoText = fmt.Sprintf("%d of %d selected", selectedCount, len(state.Leagues))
 	}
+	helpText := constants.HelpSettingsView
+
+	// Notifications section replaces the league list
+	if state.Section == SettingsNotifications {
+		title = titleStyle.Render("Notifications")
+		listContent = state.Notifications.View()
+		infoText = state.notificationInfo()
+		helpText = constants.HelpSettingsNotifications
+	}
+
+	// Favourites section replaces it too, under the team search box
+	if state.Section == SettingsFavourites {
+		title = titleStyle.Render("Favourite Teams")
+		listContent = lipgloss.JoinVertical(lipgloss.Left, state.Search.View(), "", state.Favourites.View())
+		infoText = state.favouritesInfo()
+		helpText = constants.HelpSettingsFavourites
+		if state.Searching {
+			helpText = constants.HelpSettingsTeamSearch
+		}
+	}
+
 	infoStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
 	info := infoStyle.Render(infoText)
+	timeInfo := infoStyle.Render(state.timeInfo())
 
 	// Help text
 	helpStyle := neonDimStyle.Align(lipgloss.Center)
-	help := helpStyle.Render(constants.HelpSettingsView)
+	help := helpStyle.Render(helpText)
 
 	// Combine content (minimal, no borders)
 	content := lipgloss.JoinVertical(
@@ -177,6 +380,7 @@ func RenderSettingsView(width, height int, state *SettingsState) string {
 		listContent,
 		"",
 		info,
+		timeInfo,
 		help,
 	)
 
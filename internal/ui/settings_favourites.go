@@ -0,0 +1,201 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// FavouriteListItem implements the list.Item interface for the favourites
+// section: a favourite team, or a team found by a search.
+type FavouriteListItem struct {
+	Team      data.FavouriteTeam
+	League    string // League name, "" when unknown
+	Favourite bool
+}
+
+// Title returns the team with its checkbox and league.
+func (f FavouriteListItem) Title() string {
+	checkbox := "[ ]"
+	if f.Favourite {
+		checkbox = "[x]"
+	}
+	if f.League == "" {
+		return fmt.Sprintf("%s %s", checkbox, f.Team.Name)
+	}
+	return fmt.Sprintf("%s %s · %s", checkbox, f.Team.Name, f.League)
+}
+
+// Description is empty: the favourites list shows one line per item.
+func (f FavouriteListItem) Description() string {
+	return ""
+}
+
+// FilterValue returns the team name (the favourites list isn't filtered).
+func (f FavouriteListItem) FilterValue() string {
+	return f.Team.Name
+}
+
+// newFavouriteList creates the list of the favourites section, with the same
+// one-line rows as the notification rules.
+func newFavouriteList(teams []data.FavouriteTeam) list.Model {
+	l := list.New(favouriteItems(teams), NewNotificationListDelegate(), 0, 0)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(false)
+	l.SetShowHelp(false) // We use our own help text
+	return l
+}
+
+// newTeamSearchInput creates the team search box of the favourites section.
+func newTeamSearchInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "Team: "
+	input.Placeholder = "e.g. Arsenal"
+	input.CharLimit = 40
+	cursorStyle, promptStyle := FilterInputStyles()
+	input.PromptStyle = promptStyle
+	input.Cursor.Style = cursorStyle
+	input.Cursor.SetMode(cursor.CursorStatic) // No blink messages to route
+	return input
+}
+
+// favouriteItems lists the favourite teams, all checked.
+func favouriteItems(teams []data.FavouriteTeam) []list.Item {
+	items := make([]list.Item, len(teams))
+	for i, team := range teams {
+		items[i] = FavouriteListItem{Team: team, League: favouriteLeagueName(team), Favourite: true}
+	}
+	return items
+}
+
+// favouriteLeagueName names the first league a favourite team was seen in.
+func favouriteLeagueName(team data.FavouriteTeam) string {
+	if len(team.Leagues) == 0 {
+		return ""
+	}
+	if info, ok := data.LeagueInfoByID(team.Leagues[0]); ok {
+		return info.Name
+	}
+	return ""
+}
+
+// StartSearch focuses the team search box.
+func (s *SettingsState) StartSearch() {
+	s.Searching = true
+	s.Search.SetValue("")
+	s.Search.Focus()
+}
+
+// CancelSearch leaves the search box, keeping any results shown.
+func (s *SettingsState) CancelSearch() {
+	s.Searching = false
+	s.Search.Blur()
+}
+
+// SubmitSearch leaves the search box and returns the query to search for,
+// "" if it is blank.
+func (s *SettingsState) SubmitSearch() string {
+	s.CancelSearch()
+	query := strings.TrimSpace(s.Search.Value())
+	if query == "" {
+		return ""
+	}
+	s.SearchQuery = query
+	s.SearchResults = nil
+	s.SearchErr = nil
+	s.SearchLoading = true
+	s.refreshFavourites()
+	return query
+}
+
+// SetSearchResults shows the teams found for a query. Results for an older
+// query are ignored.
+func (s *SettingsState) SetSearchResults(query string, results []api.TeamSearchResult, err error) {
+	if query != s.SearchQuery {
+		return
+	}
+	s.SearchLoading = false
+	s.SearchResults = results
+	s.SearchErr = err
+	s.refreshFavourites()
+	s.Favourites.Select(0)
+}
+
+// ClearSearch goes back from the search results to the favourite teams.
+func (s *SettingsState) ClearSearch() {
+	s.SearchQuery = ""
+	s.SearchResults = nil
+	s.SearchErr = nil
+	s.SearchLoading = false
+	s.refreshFavourites()
+	s.Favourites.Select(0)
+}
+
+// ShowingSearch reports whether the favourites section is showing or typing
+// a search (Esc then leaves the search rather than the settings view).
+func (s *SettingsState) ShowingSearch() bool {
+	return s.Searching || s.SearchQuery != ""
+}
+
+// ToggleFavourite adds the highlighted team to the favourites, or removes it.
+func (s *SettingsState) ToggleFavourite() {
+	item, ok := s.Favourites.SelectedItem().(FavouriteListItem)
+	if !ok {
+		return
+	}
+	settings := data.Settings{FavouriteTeams: s.FavouriteTeams}
+	settings.ToggleFavourite(item.Team)
+	s.FavouriteTeams = settings.FavouriteTeams
+	s.FavouritesChanged = true
+	s.HasChanges = true
+
+	index := s.Favourites.Index()
+	s.refreshFavourites()
+	s.Favourites.Select(min(index, len(s.Favourites.Items())-1))
+}
+
+// refreshFavourites lists the search results, or the favourite teams when
+// there is no search.
+func (s *SettingsState) refreshFavourites() {
+	if s.SearchQuery == "" {
+		s.Favourites.SetItems(favouriteItems(s.FavouriteTeams))
+		return
+	}
+
+	settings := data.Settings{FavouriteTeams: s.FavouriteTeams}
+	items := make([]list.Item, len(s.SearchResults))
+	for i, result := range s.SearchResults {
+		team := data.FavouriteTeam{ID: result.Team.ID, Name: result.Team.Name}
+		if result.League.ID != 0 {
+			team.Leagues = []int{result.League.ID}
+		}
+		items[i] = FavouriteListItem{Team: team, League: result.League.Name, Favourite: settings.IsFavourite(team.ID)}
+	}
+	s.Favourites.SetItems(items)
+}
+
+// favouritesInfo describes the favourites section, e.g., "2 favourite teams"
+// or the state of a search.
+func (s *SettingsState) favouritesInfo() string {
+	switch {
+	case s.SearchLoading:
+		return fmt.Sprintf("Searching for %q...", s.SearchQuery)
+	case s.SearchErr != nil:
+		return "Search failed: " + s.SearchErr.Error()
+	case s.SearchQuery != "" && len(s.SearchResults) == 0:
+		return fmt.Sprintf("No teams found for %q", s.SearchQuery)
+	case s.SearchQuery != "":
+		return fmt.Sprintf("%d teams found for %q", len(s.SearchResults), s.SearchQuery)
+	case len(s.FavouriteTeams) == 0:
+		return "No favourites: / to search, or f on a match"
+	case len(s.FavouriteTeams) == 1:
+		return "1 favourite team"
+	}
+	return fmt.Sprintf("%d favourite teams", len(s.FavouriteTeams))
+}
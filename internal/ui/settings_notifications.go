@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// SettingsSection is the part of the settings view being edited.
+type SettingsSection int
+
+const (
+	SettingsLeagues SettingsSection = iota
+	SettingsNotifications
+	SettingsFavourites
+)
+
+// NotificationListItem implements the list.Item interface for the
+// notifications section: a rule, or one of its events when Event is set.
+type NotificationListItem struct {
+	Rule    int // Index in SettingsState.Rules
+	Event   data.NotificationEvent
+	Name    string
+	Summary string
+	Enabled bool
+}
+
+// Title returns the rule or event with its checkbox. Events are indented
+// under their rule.
+func (n NotificationListItem) Title() string {
+	checkbox := "[ ]"
+	if n.Enabled {
+		checkbox = "[x]"
+	}
+	if n.Event != "" {
+		return fmt.Sprintf("   %s %s", checkbox, n.Event.Label())
+	}
+	return fmt.Sprintf("%s %s · %s", checkbox, n.Name, n.Summary)
+}
+
+// Description is empty: the notifications list shows one line per item.
+func (n NotificationListItem) Description() string {
+	return ""
+}
+
+// FilterValue returns the rule name (the notifications list isn't filtered).
+func (n NotificationListItem) FilterValue() string {
+	return n.Name
+}
+
+// newNotificationList creates the list of the notifications section.
+func newNotificationList(rules []data.NotificationRule) list.Model {
+	l := list.New(notificationItems(rules), NewNotificationListDelegate(), 0, 0)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(false)
+	l.SetShowHelp(false) // We use our own help text
+	return l
+}
+
+// notificationItems lists every rule followed by its events.
+func notificationItems(rules []data.NotificationRule) []list.Item {
+	var items []list.Item
+	for i, rule := range rules {
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("Rule %d", i+1)
+		}
+		items = append(items, NotificationListItem{Rule: i, Name: name, Summary: rule.Summary(), Enabled: !rule.Disabled})
+		for _, event := range data.NotificationEvents {
+			items = append(items, NotificationListItem{Rule: i, Event: event, Name: name, Enabled: rule.Has(event)})
+		}
+	}
+	return items
+}
+
+// ToggleSection switches to a section, or back to the leagues if it is
+// already shown.
+func (s *SettingsState) ToggleSection(section SettingsSection) {
+	if s.Section == section {
+		s.Section = SettingsLeagues
+	} else {
+		s.Section = section
+	}
+}
+
+// ToggleNotification turns the highlighted rule, or event of a rule, on or off.
+func (s *SettingsState) ToggleNotification() {
+	item, ok := s.Notifications.SelectedItem().(NotificationListItem)
+	if !ok {
+		return
+	}
+	rule := &s.Rules[item.Rule]
+	if item.Event == "" {
+		rule.Disabled = !rule.Disabled
+	} else if rule.Has(item.Event) {
+		var events []data.NotificationEvent
+		for _, e := range rule.Events {
+			if e != item.Event {
+				events = append(events, e)
+			}
+		}
+		rule.Events = events
+	} else {
+		// Keep the events in the order the settings view shows them
+		var events []data.NotificationEvent
+		for _, e := range data.NotificationEvents {
+			if e == item.Event || rule.Has(e) {
+				events = append(events, e)
+			}
+		}
+		rule.Events = events
+	}
+	s.rulesChanged()
+}
+
+// AddRule adds a rule for goals in every followed match after the
+// highlighted one. Filters are set in settings.yaml.
+func (s *SettingsState) AddRule() {
+	at := len(s.Rules)
+	if item, ok := s.Notifications.SelectedItem().(NotificationListItem); ok {
+		at = item.Rule + 1
+	}
+	rule := data.NotificationRule{
+		Name:   fmt.Sprintf("Rule %d", len(s.Rules)+1),
+		Events: []data.NotificationEvent{data.NotifyGoal},
+	}
+	s.Rules = append(s.Rules[:at], append([]data.NotificationRule{rule}, s.Rules[at:]...)...)
+	s.rulesChanged()
+	s.selectRule(at)
+}
+
+// DeleteRule removes the highlighted rule. With no rules left, goals are
+// notified in every followed match (see data.DefaultNotificationRules).
+func (s *SettingsState) DeleteRule() {
+	item, ok := s.Notifications.SelectedItem().(NotificationListItem)
+	if !ok {
+		return
+	}
+	s.Rules = append(s.Rules[:item.Rule], s.Rules[item.Rule+1:]...)
+	s.rulesChanged()
+	s.selectRule(min(item.Rule, len(s.Rules)-1))
+}
+
+// NextRule moves the cursor to the next rule.
+func (s *SettingsState) NextRule() {
+	if item, ok := s.Notifications.SelectedItem().(NotificationListItem); ok && item.Rule+1 < len(s.Rules) {
+		s.selectRule(item.Rule + 1)
+	}
+}
+
+// PrevRule moves the cursor to the current rule, or to the previous rule if
+// already there.
+func (s *SettingsState) PrevRule() {
+	item, ok := s.Notifications.SelectedItem().(NotificationListItem)
+	if !ok {
+		return
+	}
+	if item.Event == "" {
+		s.selectRule(max(item.Rule-1, 0))
+	} else {
+		s.selectRule(item.Rule)
+	}
+}
+
+// selectRule moves the cursor to a rule's row.
+func (s *SettingsState) selectRule(rule int) {
+	for i, item := range s.Notifications.Items() {
+		if n, ok := item.(NotificationListItem); ok && n.Rule == rule && n.Event == "" {
+			s.Notifications.Select(i)
+			return
+		}
+	}
+}
+
+// rulesChanged refreshes the notifications list after an edit, keeping the
+// cursor where it was.
+func (s *SettingsState) rulesChanged() {
+	index := s.Notifications.Index()
+	s.Notifications.SetItems(notificationItems(s.Rules))
+	s.Notifications.Select(min(index, len(s.Notifications.Items())-1))
+	s.RulesChanged = true
+	s.HasChanges = true
+}
+
+// notificationInfo describes the notification rules, e.g., "2 of 3 rules on".
+func (s *SettingsState) notificationInfo() string {
+	if len(s.Rules) == 0 {
+		return "No rules = goals in every followed match"
+	}
+	on := 0
+	for _, rule := range s.Rules {
+		if !rule.Disabled {
+			on++
+		}
+	}
+	return fmt.Sprintf("%d of %d rules on", on, len(s.Rules))
+}
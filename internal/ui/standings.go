@@ -0,0 +1,288 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StandingsState holds the state for the standings view.
+type StandingsState struct {
+	Leagues   []data.LeagueInfo      // Leagues available in the picker (from settings)
+	Index     int                    // Currently selected league in Leagues
+	Entries   []api.LeagueTableEntry // Table rows for the selected league (all groups)
+	Highlight map[int]bool           // Team IDs to highlight (teams in the selected match)
+	Offset    int                    // Scroll offset in rendered lines
+	Loading   bool
+	Err       error
+}
+
+// NewStandingsState creates a standings state for the given leagues.
+// If focusLeagueID is non-zero, the picker starts on that league (added if missing).
+// highlightTeams are team IDs to highlight in the table.
+func NewStandingsState(leagues []data.LeagueInfo, focusLeagueID int, highlightTeams ...int) *StandingsState {
+	state := &StandingsState{
+		Highlight: make(map[int]bool),
+	}
+	state.Leagues, state.Index = focusLeague(leagues, focusLeagueID)
+
+	for _, id := range highlightTeams {
+		if id != 0 {
+			state.Highlight[id] = true
+		}
+	}
+
+	return state
+}
+
+// focusLeague returns the picker leagues and the index to start on.
+// If focusLeagueID is non-zero but not in leagues (e.g., the league of the
+// selected match isn't in the user's selection), it is shown first.
+func focusLeague(leagues []data.LeagueInfo, focusLeagueID int) ([]data.LeagueInfo, int) {
+	if focusLeagueID == 0 {
+		return leagues, 0
+	}
+
+	for i, league := range leagues {
+		if league.ID == focusLeagueID {
+			return leagues, i
+		}
+	}
+
+	info, ok := data.LeagueInfoByID(focusLeagueID)
+	if !ok {
+		info = data.LeagueInfo{ID: focusLeagueID, Name: fmt.Sprintf("League %d", focusLeagueID)}
+	}
+	return append([]data.LeagueInfo{info}, leagues...), 0
+}
+
+// CurrentLeague returns the league currently selected in the picker.
+func (s *StandingsState) CurrentLeague() data.LeagueInfo {
+	if len(s.Leagues) == 0 {
+		return data.LeagueInfo{}
+	}
+	return s.Leagues[s.Index]
+}
+
+// NextLeague moves the picker to the next league (wraps around).
+func (s *StandingsState) NextLeague() {
+	if len(s.Leagues) == 0 {
+		return
+	}
+	s.Index = (s.Index + 1) % len(s.Leagues)
+	s.reset()
+}
+
+// PrevLeague moves the picker to the previous league (wraps around).
+func (s *StandingsState) PrevLeague() {
+	if len(s.Leagues) == 0 {
+		return
+	}
+	s.Index = (s.Index - 1 + len(s.Leagues)) % len(s.Leagues)
+	s.reset()
+}
+
+// ScrollDown scrolls the table down by n lines.
+func (s *StandingsState) ScrollDown(n int) {
+	s.Offset += n
+}
+
+// ScrollUp scrolls the table up by n lines.
+func (s *StandingsState) ScrollUp(n int) {
+	s.Offset -= n
+	if s.Offset < 0 {
+		s.Offset = 0
+	}
+}
+
+// reset clears table data before loading a new league.
+func (s *StandingsState) reset() {
+	s.Entries = nil
+	s.Err = nil
+	s.Offset = 0
+}
+
+// Fixed width for the standings table
+const standingsBoxWidth = 64
+
+// RenderStandingsView renders the standings view with league picker, table and zone legend.
+// Uses minimal styling consistent with the settings view (red/cyan neon theme).
+func RenderStandingsView(width, height int, state *StandingsState, randomSpinner *RandomCharSpinner) string {
+	if state == nil {
+		return ""
+	}
+
+	const (
+		titleHeight  = 2 // Title + league picker
+		helpHeight   = 2 // Legend + help text
+		extraPadding = 4 // Additional vertical spacing
+	)
+
+	title := neonPanelTitleStyle.Width(standingsBoxWidth).Render(constants.PanelStandings)
+	picker := renderLeaguePicker(state, standingsBoxWidth)
+
+	// Body: spinner, error/empty message, or table
+	var body string
+	bodyHeight := height - titleHeight - helpHeight - extraPadding - 2
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+
+	emptyStyle := neonEmptyStyle.Width(standingsBoxWidth)
+	switch {
+	case state.Loading:
+		spinnerView := "Loading..."
+		if randomSpinner != nil {
+			spinnerView = randomSpinner.View()
+		}
+		body = emptyStyle.Render(spinnerView)
+	case state.Err != nil:
+		body = emptyStyle.Render("Could not load table\n\n" + state.Err.Error())
+	case len(state.Entries) == 0:
+		body = emptyStyle.Render(constants.EmptyNoStandings)
+	default:
+		lines := renderStandingsTable(state.Entries, state.Highlight)
+		// Clamp scroll offset so the last line stays in view
+		maxOffset := len(lines) - bodyHeight
+		if maxOffset < 0 {
+			maxOffset = 0
+		}
+		if state.Offset > maxOffset {
+			state.Offset = maxOffset
+		}
+		end := state.Offset + bodyHeight
+		if end > len(lines) {
+			end = len(lines)
+		}
+		body = strings.Join(lines[state.Offset:end], "\n")
+	}
+
+	legend := renderZoneLegend(state.Entries, standingsBoxWidth)
+	help := neonDimStyle.Width(standingsBoxWidth).Align(lipgloss.Center).Render(constants.HelpStandingsView)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		picker,
+		"",
+		body,
+		"",
+		legend,
+		help,
+	)
+
+	return lipgloss.Place(
+		width,
+		height,
+		lipgloss.Center,
+		lipgloss.Center,
+		content,
+	)
+}
+
+// renderLeaguePicker renders "‹ League Name (2/5) ›" centered.
+func renderLeaguePicker(state *StandingsState, width int) string {
+	return renderPicker(state.CurrentLeague().Name, "No leagues selected", state.Index, len(state.Leagues), width)
+}
+
+// renderPicker renders "‹ Name (2/5) ›" centered; the position is omitted for a single item.
+func renderPicker(name, emptyName string, index, total, width int) string {
+	if name == "" {
+		name = emptyName
+	}
+
+	position := ""
+	if total > 1 {
+		position = neonDimStyle.Render(fmt.Sprintf(" (%d/%d)", index+1, total))
+	}
+
+	picker := neonDimStyle.Render("‹ ") + neonDateSelectedStyle.Render(name) + position + neonDimStyle.Render(" ›")
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(picker)
+}
+
+// renderStandingsTable renders table rows, inserting a header for each group.
+// Each row starts with a colored zone marker; highlighted teams are shown in neon red.
+func renderStandingsTable(entries []api.LeagueTableEntry, highlight map[int]bool) []string {
+	header := neonDimStyle.Render(fmt.Sprintf("  %3s  %-24s %3s %3s %3s %3s %7s %4s %4s",
+		"#", "Team", "P", "W", "D", "L", "Goals", "GD", "Pts"))
+
+	var lines []string
+	currentGroup := ""
+	for i, entry := range entries {
+		if i == 0 || entry.Group != currentGroup {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			if entry.Group != "" {
+				lines = append(lines, neonHeaderStyle.Render(entry.Group))
+			}
+			lines = append(lines, header)
+			currentGroup = entry.Group
+		}
+		lines = append(lines, renderStandingsRow(entry, highlight[entry.Team.ID]))
+	}
+	return lines
+}
+
+// renderStandingsRow renders a single table row.
+func renderStandingsRow(entry api.LeagueTableEntry, highlighted bool) string {
+	marker := " "
+	if entry.ZoneColor != "" {
+		marker = lipgloss.NewStyle().Foreground(lipgloss.Color(entry.ZoneColor)).Render("▌")
+	}
+
+	name := entry.Team.ShortName
+	if name == "" {
+		name = entry.Team.Name
+	}
+	name = truncateString(name, 24)
+
+	gd := fmt.Sprintf("%d", entry.GoalDifference)
+	if entry.GoalDifference > 0 {
+		gd = "+" + gd
+	}
+
+	row := fmt.Sprintf("%3d  %-24s %3d %3d %3d %3d %7s %4s %4d",
+		entry.Position,
+		name,
+		entry.Played,
+		entry.Won,
+		entry.Drawn,
+		entry.Lost,
+		fmt.Sprintf("%d:%d", entry.GoalsFor, entry.GoalsAgainst),
+		gd,
+		entry.Points,
+	)
+
+	style := neonValueStyle
+	if highlighted {
+		style = neonScoreStyle
+	}
+	return marker + " " + style.Render(row)
+}
+
+// renderZoneLegend lists the qualification/relegation zones present in the table.
+func renderZoneLegend(entries []api.LeagueTableEntry, width int) string {
+	seen := make(map[string]bool)
+	var items []string
+	for _, entry := range entries {
+		if entry.Zone == "" || seen[entry.Zone] {
+			continue
+		}
+		seen[entry.Zone] = true
+		marker := "▌"
+		if entry.ZoneColor != "" {
+			marker = lipgloss.NewStyle().Foreground(lipgloss.Color(entry.ZoneColor)).Render(marker)
+		}
+		items = append(items, marker+" "+neonDimStyle.Render(entry.Zone))
+	}
+
+	if len(items) == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(strings.Join(items, "   "))
+}
@@ -0,0 +1,157 @@
+package watch
+
+import (
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+// Event types, one per kind of change.
+const (
+	EventGoal           = "goal"            // A goal, with its scorer
+	EventScore          = "score"           // The score moved before the goal was published
+	EventGoalDisallowed = "goal_disallowed" // A goal taken back (e.g., by VAR)
+	EventStatus         = "status"          // Kickoff, half-time, full-time...
+	EventCard           = "card"
+	EventSubstitution   = "substitution"
+	EventPenalty        = "penalty" // A penalty during play, scored or missed
+	EventLineup         = "lineup"
+	EventEdited         = "event_edited" // A published event was corrected
+)
+
+// Event is a change of a watched match as one flat record, for streaming
+// outside the app (golazo watch writes one JSON object per event).
+type Event struct {
+	Type      string    `json:"type"`
+	Time      time.Time `json:"time"` // When the change was seen
+	MatchID   int       `json:"match_id"`
+	LeagueID  int       `json:"league_id"`
+	League    string    `json:"league"`
+	Home      string    `json:"home"`
+	Away      string    `json:"away"`
+	HomeScore int       `json:"home_score"` // Score after the change
+	AwayScore int       `json:"away_score"`
+	Clock     string    `json:"clock,omitempty"` // Match clock when seen ("67'", "HT")
+
+	Minute int    `json:"minute,omitempty"` // Minute of the match event
+	Team   string `json:"team,omitempty"`
+	Player string `json:"player,omitempty"` // Scorer, booked player, or player going off
+	Assist string `json:"assist,omitempty"` // Goal assist, or player coming on
+	// Detail qualifies the event: the card ("yellow", "red"), the penalty
+	// ("scored", "missed"), the formations of a line-up ("4-3-3 4-4-2")
+	Detail string `json:"detail,omitempty"`
+	From   string `json:"from,omitempty"` // Phases of a status change ("first_half", "half_time")
+	To     string `json:"to,omitempty"`
+}
+
+// Events returns the changes of a poll as events seen at.
+func Events(update MatchUpdate, at time.Time) []Event {
+	details := update.Details
+	if details == nil {
+		return nil
+	}
+
+	events := make([]Event, 0, len(update.Changes))
+	for _, change := range update.Changes {
+		e := Event{
+			Time:      at,
+			MatchID:   details.ID,
+			LeagueID:  details.League.ID,
+			League:    details.League.Name,
+			Home:      details.HomeTeam.Name,
+			Away:      details.AwayTeam.Name,
+			HomeScore: intValue(details.HomeScore),
+			AwayScore: intValue(details.AwayScore),
+			Clock:     stringValue(details.LiveTime),
+		}
+
+		switch c := change.(type) {
+		case matchdiff.ScoreChanged:
+			e.Type = EventScore
+			e.Team = teamName(c.Team)
+			e.HomeScore, e.AwayScore = c.Home, c.Away
+			if c.Goal != nil {
+				e.Type = EventGoal
+				e.withMatchEvent(*c.Goal)
+			}
+		case matchdiff.GoalDisallowed:
+			e.Type = EventGoalDisallowed
+			e.Team = teamName(c.Team)
+			e.HomeScore, e.AwayScore = c.Home, c.Away
+			if c.Goal != nil {
+				e.withMatchEvent(*c.Goal)
+			}
+		case matchdiff.StatusChanged:
+			e.Type = EventStatus
+			e.From, e.To = phaseName(c.From), phaseName(c.To)
+			if c.Clock != "" {
+				e.Clock = c.Clock
+			}
+		case matchdiff.CardShown:
+			e.Type = EventCard
+			e.withMatchEvent(c.Card)
+			e.Detail = "yellow"
+			if c.Red {
+				e.Detail = "red"
+			}
+		case matchdiff.SubstitutionMade:
+			e.Type = EventSubstitution
+			e.withMatchEvent(c.Substitution)
+		case matchdiff.PenaltyAwarded:
+			e.Type = EventPenalty
+			e.withMatchEvent(c.Penalty)
+			e.Detail = "missed"
+			if c.Scored {
+				e.Detail = "scored"
+			}
+		case matchdiff.LineupAnnounced:
+			e.Type = EventLineup
+			e.Detail = strings.TrimSpace(c.HomeFormation + " " + c.AwayFormation)
+		case matchdiff.EventEdited:
+			e.Type = EventEdited
+			e.withMatchEvent(c.After)
+		default:
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// withMatchEvent fills in the minute, team and players of a match event.
+func (e *Event) withMatchEvent(event api.MatchEvent) {
+	e.Minute = event.Minute
+	e.Team = teamName(event.Team)
+	e.Player = stringValue(event.Player)
+	e.Assist = stringValue(event.Assist)
+}
+
+// phaseName returns a phase as an identifier ("half_time").
+func phaseName(p matchdiff.Phase) string {
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(p.String()))
+}
+
+// teamName returns a team's name, or its short name for events that only
+// carry that.
+func teamName(team api.Team) string {
+	if team.Name != "" {
+		return team.Name
+	}
+	return team.ShortName
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,57 @@
+package watch_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+	"github.com/0xjuanma/golazo/internal/watch"
+)
+
+func TestEvents(t *testing.T) {
+	home := api.Team{ID: 9825, Name: "Arsenal", ShortName: "ARS"}
+	away := api.Team{ID: 8455, Name: "Chelsea", ShortName: "CHE"}
+	homeScore, awayScore, clock := 1, 0, "67'"
+	scorer, assist, red := "Saka", "Ødegaard", "red"
+	details := &api.MatchDetails{Match: api.Match{
+		ID: 100, League: api.League{ID: 47, Name: "Premier League"}, HomeTeam: home, AwayTeam: away,
+		Status: api.MatchStatusLive, HomeScore: &homeScore, AwayScore: &awayScore, LiveTime: &clock,
+	}}
+	goal := api.MatchEvent{Minute: 64, Type: "goal", Team: home, Player: &scorer, Assist: &assist}
+	card := api.MatchEvent{Minute: 66, Type: "card", Team: api.Team{ShortName: "CHE"}, Player: &scorer, EventType: &red}
+	at := time.Date(2025, 5, 10, 15, 0, 0, 0, time.UTC)
+
+	events := watch.Events(watch.MatchUpdate{Details: details, Changes: []matchdiff.Change{
+		matchdiff.ScoreChanged{Team: home, Home: 1, Away: 0, Goal: &goal},
+		matchdiff.CardShown{Card: card, Red: true},
+		matchdiff.StatusChanged{From: matchdiff.PhaseHalfTime, To: matchdiff.PhaseSecondHalf, Clock: "46'"},
+	}}, at)
+
+	want := []string{
+		`{"type":"goal","time":"2025-05-10T15:00:00Z","match_id":100,"league_id":47,"league":"Premier League","home":"Arsenal","away":"Chelsea","home_score":1,"away_score":0,"clock":"67'","minute":64,"team":"Arsenal","player":"Saka","assist":"Ødegaard"}`,
+		`{"type":"card","time":"2025-05-10T15:00:00Z","match_id":100,"league_id":47,"league":"Premier League","home":"Arsenal","away":"Chelsea","home_score":1,"away_score":0,"clock":"67'","minute":66,"team":"CHE","player":"Saka","detail":"red"}`,
+		`{"type":"status","time":"2025-05-10T15:00:00Z","match_id":100,"league_id":47,"league":"Premier League","home":"Arsenal","away":"Chelsea","home_score":1,"away_score":0,"clock":"46'","from":"half_time","to":"second_half"}`,
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	for i, event := range events {
+		got, err := json.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want[i] {
+			t.Errorf("event %d:\n got %s\nwant %s", i, got, want[i])
+		}
+	}
+
+	// A goal not published yet is a score change
+	events = watch.Events(watch.MatchUpdate{Details: details, Changes: []matchdiff.Change{
+		matchdiff.ScoreChanged{Team: away, Home: 1, Away: 1},
+	}}, at)
+	if len(events) != 1 || events[0].Type != watch.EventScore || events[0].Team != "Chelsea" || events[0].AwayScore != 1 {
+		t.Errorf("score change = %+v, want a score event for Chelsea at 1-1", events)
+	}
+}
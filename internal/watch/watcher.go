@@ -0,0 +1,284 @@
+// Package watch polls every live match of the followed leagues in the
+// background and reports what changed in each (see matchdiff). Matches about
+// to kick off are polled too, until their line-ups are out.
+//
+// A Watcher doesn't run on its own: callers call Poll and wait the interval it
+// returns before polling again, so the TUI can drive it with tea.Tick and
+// command-line tools with a plain loop.
+package watch
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+)
+
+// DefaultInterval is the interval between polls while matches are being played.
+const DefaultInterval = 90 * time.Second
+
+// kickoffWindow is how far into a match it can first be seen and still be
+// reported as kicking off. Matches found later (e.g., on startup) are only
+// compared from then on.
+const kickoffWindow = 10
+
+// lineupWindow is how long before kickoff a match is polled for its line-ups,
+// which are usually published an hour before.
+const lineupWindow = 75 * time.Minute
+
+// MatchUpdate is the latest snapshot of a watched match and what changed
+// since the previous poll. Changes is empty the first time a match is seen.
+type MatchUpdate struct {
+	Details *api.MatchDetails
+	Changes []matchdiff.Change
+}
+
+// Update is the result of a poll.
+type Update struct {
+	Matches []MatchUpdate // Every match polled: live list order, then upcoming ones
+	Next    time.Duration // When to poll again
+	Err     error         // Set when the live matches couldn't be listed
+}
+
+// Watcher keeps the last snapshot of every live match, and of matches about to
+// kick off, to compare the next poll against. It is safe for concurrent use; polls run one at a time.
+type Watcher struct {
+	client   api.Provider
+	interval time.Duration
+
+	mu        sync.Mutex
+	snapshots map[int]*api.MatchDetails
+	prematch  map[int]*api.MatchDetails // Upcoming matches within lineupWindow
+	polls     int                       // Completed polls
+	failures  int                       // Consecutive failed polls
+}
+
+// New creates a watcher polling the provider's live matches. interval is the
+// poll interval while matches are being played (DefaultInterval when 0); it
+// is shortened near the end of matches and lengthened when nothing is on.
+func New(client api.Provider, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Watcher{
+		client:    client,
+		interval:  interval,
+		snapshots: make(map[int]*api.MatchDetails),
+		prematch:  make(map[int]*api.MatchDetails),
+	}
+}
+
+// Poll lists the live matches, fetches the details of each (and of watched
+// matches that just left the live list, to see them finish, and of upcoming
+// matches still waiting for their line-ups) and diffs them against the
+// previous poll. Matches whose details fail to load are skipped until the
+// next poll.
+//
+// Details are force refreshed, so the provider's cache holds them for
+// whoever asks next (e.g., the match details panel).
+func (w *Watcher) Poll(ctx context.Context) Update {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	live, err := w.liveMatches(ctx)
+	// Leagues that failed keep their matches out of this poll only
+	if partial, ok := api.AsPartialResult(err); err != nil && (!ok || partial.AllFailed()) {
+		w.failures++
+		return Update{Next: w.backoff(), Err: err}
+	}
+	w.failures = 0
+
+	ids := make([]int, 0, len(live)+len(w.snapshots))
+	listed := make(map[int]bool, len(live))
+	for _, match := range live {
+		if !listed[match.ID] {
+			listed[match.ID] = true
+			ids = append(ids, match.ID)
+		}
+	}
+	var left []int
+	for id := range w.snapshots {
+		if !listed[id] {
+			left = append(left, id)
+		}
+	}
+	sort.Ints(left)
+	ids = append(ids, left...)
+	watched := len(ids)
+	ids = append(ids, w.upcomingMatches(ctx, listed)...)
+
+	update := Update{}
+	for i, details := range w.fetchDetails(ctx, ids) {
+		if details == nil {
+			continue
+		}
+		if i >= watched && details.Status == api.MatchStatusNotStarted {
+			update.Matches = append(update.Matches, w.compareUpcoming(ids[i], details))
+			continue
+		}
+		update.Matches = append(update.Matches, w.compare(ids[i], details))
+	}
+	w.polls++
+	update.Next = w.nextInterval()
+	return update
+}
+
+// liveMatches lists the live matches. While matches are being watched the
+// provider's cached list is good enough (its TTL is shorter than kickoffWindow);
+// idle polls are further apart than that and refresh it.
+func (w *Watcher) liveMatches(ctx context.Context) ([]api.Match, error) {
+	if len(w.snapshots) == 0 {
+		return w.client.LiveMatchesForceRefresh(ctx)
+	}
+	return w.client.LiveMatches(ctx)
+}
+
+// upcomingMatches lists the matches kicking off within lineupWindow whose
+// line-ups haven't been seen yet, and forgets the ones that left the window.
+// The day's fixtures come from the provider's cache; a failed list leaves
+// the upcoming matches out of this poll.
+func (w *Watcher) upcomingMatches(ctx context.Context, listed map[int]bool) []int {
+	now := data.Now()
+	end := now.Add(lineupWindow)
+	days := []time.Time{now}
+	if data.DateKey(end) != data.DateKey(now) {
+		days = append(days, end)
+	}
+
+	var ids []int
+	window := make(map[int]bool)
+	for _, day := range days {
+		matches, err := w.client.MatchesByDate(ctx, day)
+		if _, partial := api.AsPartialResult(err); err != nil && !partial {
+			return nil
+		}
+		for _, match := range matches {
+			if match.Status != api.MatchStatusNotStarted || match.MatchTime == nil ||
+				match.MatchTime.Before(now) || match.MatchTime.After(end) ||
+				listed[match.ID] || w.snapshots[match.ID] != nil || window[match.ID] {
+				continue
+			}
+			window[match.ID] = true
+			if prev := w.prematch[match.ID]; prev == nil || !matchdiff.HasLineup(prev) {
+				ids = append(ids, match.ID)
+			}
+		}
+	}
+	for id := range w.prematch {
+		if !window[id] {
+			delete(w.prematch, id)
+		}
+	}
+	return ids
+}
+
+// fetchDetails fetches the details of every match concurrently, with nil for
+// the ones that failed.
+func (w *Watcher) fetchDetails(ctx context.Context, ids []int) []*api.MatchDetails {
+	results := make([]*api.MatchDetails, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			details, err := w.client.MatchDetailsForceRefresh(ctx, id)
+			if err == nil {
+				results[i] = details
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// compare diffs a match against its previous snapshot and stores the new one.
+// Matches that are over are no longer watched.
+func (w *Watcher) compare(id int, details *api.MatchDetails) MatchUpdate {
+	prev := w.snapshots[id]
+	if prev == nil && w.polls > 0 && justKickedOff(details) {
+		prev = beforeKickoff(details)
+	}
+
+	delete(w.prematch, id)
+	switch details.Status {
+	case api.MatchStatusLive:
+		w.snapshots[id] = details
+	default:
+		delete(w.snapshots, id)
+	}
+	return MatchUpdate{Details: details, Changes: matchdiff.Diff(prev, details)}
+}
+
+// compareUpcoming diffs a match that hasn't kicked off against its previous
+// snapshot, which reports its line-ups coming out.
+func (w *Watcher) compareUpcoming(id int, details *api.MatchDetails) MatchUpdate {
+	prev := w.prematch[id]
+	w.prematch[id] = details
+	return MatchUpdate{Details: details, Changes: matchdiff.Diff(prev, details)}
+}
+
+// justKickedOff reports whether a match first seen live started so recently
+// that it was upcoming at the previous poll.
+func justKickedOff(details *api.MatchDetails) bool {
+	if matchdiff.PhaseOf(details.Match) != matchdiff.PhaseFirstHalf {
+		return false
+	}
+	minute, ok := matchdiff.ClockMinute(details.Match)
+	return ok && minute <= kickoffWindow
+}
+
+// beforeKickoff returns the match as it was before kickoff: no score, no
+// events, line-ups already out.
+func beforeKickoff(details *api.MatchDetails) *api.MatchDetails {
+	prev := *details
+	prev.Status = api.MatchStatusNotStarted
+	prev.LiveTime = nil
+	prev.HomeScore, prev.AwayScore = nil, nil
+	prev.Events = nil
+	return &prev
+}
+
+// nextInterval picks the next poll interval from the watched matches: a
+// third of the interval when a match is in its closing minutes, extra time
+// or penalties; twice the interval when every match is at half-time; and the
+// idle interval when nothing is being played.
+func (w *Watcher) nextInterval() time.Duration {
+	if len(w.snapshots) == 0 {
+		return w.idleInterval()
+	}
+
+	next := 2 * w.interval
+	for _, details := range w.snapshots {
+		switch phase := matchdiff.PhaseOf(details.Match); phase {
+		case matchdiff.PhaseHalfTime:
+		case matchdiff.PhaseExtraTime, matchdiff.PhasePenalties:
+			return w.interval / 3
+		default:
+			if minute, ok := matchdiff.ClockMinute(details.Match); ok && phase == matchdiff.PhaseSecondHalf && minute >= 80 {
+				return w.interval / 3
+			}
+			next = w.interval
+		}
+	}
+	return next
+}
+
+// idleInterval is the interval while no match is live: long enough to spare
+// the API, short enough to catch kickoffs within kickoffWindow.
+func (w *Watcher) idleInterval() time.Duration {
+	return w.interval * 10 / 3
+}
+
+// backoff doubles the interval after each consecutive failure, up to the idle
+// interval.
+func (w *Watcher) backoff() time.Duration {
+	next := w.interval
+	for i := 1; i < w.failures && next < w.idleInterval(); i++ {
+		next *= 2
+	}
+	return min(next, w.idleInterval())
+}
@@ -0,0 +1,162 @@
+package watch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/fotmobfake"
+	"github.com/0xjuanma/golazo/internal/matchdiff"
+	"github.com/0xjuanma/golazo/internal/watch"
+)
+
+const interval = 90 * time.Second
+
+var (
+	arsenal = fotmobfake.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}
+	chelsea = fotmobfake.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"}
+)
+
+// setup returns a watcher over a fake FotMob server with the Premier League
+// selected.
+func setup(t *testing.T) (*fotmobfake.Server, *watch.Watcher) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	settings := &data.Settings{SelectedLeagues: []int{47}, Timezone: "UTC"}
+	if err := data.SaveSettings(settings); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+	data.ApplyTimeSettings(settings)
+	t.Cleanup(func() { data.ApplyTimeSettings(nil) })
+
+	fake := fotmobfake.New()
+	t.Cleanup(fake.Close)
+	fake.AddLeague(fotmobfake.League{ID: 47, Name: "Premier League", Country: "England", CountryCode: "ENG"})
+
+	return fake, watch.New(fotmob.NewClientWithTransport(fake.Transport()), interval)
+}
+
+func poll(t *testing.T, w *watch.Watcher) watch.Update {
+	t.Helper()
+	update := w.Poll(context.Background())
+	if update.Err != nil {
+		t.Fatalf("poll: %v", update.Err)
+	}
+	return update
+}
+
+// kinds returns the kinds of the changes of the only match polled.
+func kinds(t *testing.T, update watch.Update) []matchdiff.Kind {
+	t.Helper()
+	if len(update.Matches) != 1 {
+		t.Fatalf("polled %d matches, want 1", len(update.Matches))
+	}
+	var out []matchdiff.Kind
+	for _, c := range update.Matches[0].Changes {
+		out = append(out, c.Kind())
+	}
+	return out
+}
+
+func TestWatcherFollowsAMatch(t *testing.T) {
+	fake, w := setup(t)
+	// Far enough ahead not to be polled for line-ups
+	match := fake.AddMatch(47, 100, arsenal, chelsea, data.Now().Add(3*time.Hour))
+
+	update := poll(t, w)
+	if len(update.Matches) != 0 || update.Next <= interval {
+		t.Fatalf("before kickoff: %d matches, next poll in %s; want none and a long wait", len(update.Matches), update.Next)
+	}
+
+	match.Kickoff().At(3)
+	update = poll(t, w)
+	if got := kinds(t, update); len(got) != 1 || got[0] != matchdiff.KindStatusChanged {
+		t.Errorf("kickoff changes = %v, want a status change", got)
+	}
+	if update.Next != interval {
+		t.Errorf("next poll in %s, want %s", update.Next, interval)
+	}
+
+	match.At(20).Goal(fotmobfake.Home, "Saka", "Rice")
+	update = poll(t, w)
+	got := kinds(t, update)
+	if len(got) != 1 || got[0] != matchdiff.KindScoreChanged {
+		t.Fatalf("goal changes = %v, want a score change", got)
+	}
+	if goal := update.Matches[0].Changes[0].(matchdiff.ScoreChanged); goal.Home != 1 || goal.Goal == nil {
+		t.Errorf("goal = %+v, want 1-0 with the event", goal)
+	}
+
+	match.HalfTime()
+	if update = poll(t, w); update.Next != 2*interval {
+		t.Errorf("at half-time next poll in %s, want %s", update.Next, 2*interval)
+	}
+	match.SecondHalf().At(85)
+	if update = poll(t, w); update.Next != interval/3 {
+		t.Errorf("at 85' next poll in %s, want %s", update.Next, interval/3)
+	}
+
+	match.FullTime()
+	update = poll(t, w)
+	if got := kinds(t, update); len(got) != 1 || got[0] != matchdiff.KindStatusChanged {
+		t.Errorf("full-time changes = %v, want a status change", got)
+	}
+	if update = poll(t, w); len(update.Matches) != 0 {
+		t.Errorf("still watching %d matches after full-time", len(update.Matches))
+	}
+}
+
+func TestWatcherReportsLineups(t *testing.T) {
+	fake, w := setup(t)
+	match := fake.AddMatch(47, 100, arsenal, chelsea, data.Now().Add(30*time.Minute))
+	fake.AddMatch(47, 101, chelsea, arsenal, data.Now().Add(3*time.Hour))
+
+	if got := kinds(t, poll(t, w)); len(got) != 0 {
+		t.Errorf("first poll changes = %v, want none", got)
+	}
+
+	match.Lineups("4-3-3", []string{"Raya", "Saliba", "Saka"}, nil, "4-2-3-1", []string{"Sánchez", "Colwill", "Palmer"}, nil)
+	if got := kinds(t, poll(t, w)); len(got) != 1 || got[0] != matchdiff.KindLineupAnnounced {
+		t.Errorf("line-up changes = %v, want the line-ups announced", got)
+	}
+	if update := poll(t, w); len(update.Matches) != 0 {
+		t.Errorf("polled %d matches after the line-ups, want none", len(update.Matches))
+	}
+
+	// Line-ups already reported aren't announced again at kickoff
+	match.Kickoff().At(2)
+	if got := kinds(t, poll(t, w)); len(got) != 1 || got[0] != matchdiff.KindStatusChanged {
+		t.Errorf("kickoff changes = %v, want a status change", got)
+	}
+}
+
+func TestWatcherJoinsMatchesInProgress(t *testing.T) {
+	fake, w := setup(t)
+	now := data.Now()
+	kickoff := time.Date(now.Year(), now.Month(), now.Day(), 15, 0, 0, 0, time.UTC)
+	fake.AddMatch(47, 100, arsenal, chelsea, kickoff).Kickoff().At(60).Goal(fotmobfake.Away, "Palmer", "")
+
+	// Goals scored before the first poll are not reported
+	if got := kinds(t, poll(t, w)); len(got) != 0 {
+		t.Errorf("first poll changes = %v, want none", got)
+	}
+}
+
+func TestWatcherBacksOff(t *testing.T) {
+	fake, w := setup(t)
+	fake.Inject(fotmobfake.EndpointAny, fotmobfake.FaultMalformedJSON, 100)
+
+	first := w.Poll(context.Background())
+	second := w.Poll(context.Background())
+	if first.Err == nil || second.Err == nil {
+		t.Fatalf("errors = %v, %v; want both polls to fail", first.Err, second.Err)
+	}
+	if second.Next <= first.Next {
+		t.Errorf("next polls in %s then %s, want a longer wait after each failure", first.Next, second.Next)
+	}
+}
@@ -18,7 +18,7 @@ func main() {
 
 	// Test: Fetch all stats data using the optimized unified function
 	fmt.Println("Fetching stats data (5 days finished + today upcoming)...")
-	fmt.Println("This makes 84 API calls: 28 for today + 14 each for 4 past days")
+	fmt.Println("Each league's results/fixtures payload is fetched once and bucketed by date")
 	fmt.Println()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
@@ -28,10 +28,13 @@ func main() {
 	statsData, err := client.FetchStatsData(ctx)
 	elapsed := time.Since(startTime)
 
-	if err != nil {
+	if err != nil && statsData == nil {
 		fmt.Printf("❌ Error fetching stats data: %v\n", err)
 		return
 	}
+	if err != nil {
+		fmt.Printf("⚠ Partial data: %v\n", err)
+	}
 
 	fmt.Printf("✓ Stats data fetched in %v\n\n", elapsed)
 
@@ -140,6 +143,10 @@ func main() {
 	fmt.Printf("  5-day finished:    %d matches\n", len(statsData.AllFinished))
 	fmt.Printf("  Today finished:    %d matches\n", len(statsData.TodayFinished))
 	fmt.Printf("  Today upcoming:    %d matches\n", len(statsData.TodayUpcoming))
+	fmt.Printf("  Requests:          %s\n", client.Stats().Requests)
+	for _, cacheStats := range client.Stats().Cache.All() {
+		fmt.Printf("  Cache:             %s\n", cacheStats)
+	}
 	fmt.Println()
 	fmt.Println("Note: Switching between Today/5d view in the app")
 	fmt.Println("      will be INSTANT (client-side filtering)")